@@ -0,0 +1,7 @@
+{{- define "csi-driver-controller.volumePools" -}}
+{{- $pools := list -}}
+{{- range $zone, $pool := .Values.volumePools -}}
+{{- $pools = append $pools (printf "%s=%s" $zone $pool) -}}
+{{- end -}}
+{{- join "," $pools -}}
+{{- end -}}
@@ -13,6 +13,7 @@ import (
 	webhookcmd "github.com/gardener/gardener/extensions/pkg/webhook/cmd"
 	"github.com/gardener/gardener/pkg/apis/core/install"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	securityinstall "github.com/gardener/gardener/pkg/apis/security/install"
 	gardenerhealthz "github.com/gardener/gardener/pkg/healthz"
 	"github.com/spf13/cobra"
 	corev1 "k8s.io/api/core/v1"
@@ -28,7 +29,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	admissioncmd "github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/admission/cmd"
+	"github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/admission/validator"
 	ironcoreinstall "github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/apis/ironcore/install"
+	"github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/features"
 	providerironcore "github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/ironcore"
 )
 
@@ -54,8 +57,9 @@ func NewAdmissionCommand(ctx context.Context) *cobra.Command {
 		webhookServerOptions = &webhookcmd.ServerOptions{
 			Namespace: os.Getenv("WEBHOOK_CONFIG_NAMESPACE"),
 		}
-		webhookSwitches = admissioncmd.GardenWebhookSwitchOptions()
-		webhookOptions  = webhookcmd.NewAddToManagerOptions(
+		webhookSwitches       = admissioncmd.GardenWebhookSwitchOptions()
+		credentialsReviewOpts = &admissioncmd.CredentialsReviewOptions{}
+		webhookOptions        = webhookcmd.NewAddToManagerOptions(
 			AdmissionName,
 			"",
 			nil,
@@ -68,6 +72,7 @@ func NewAdmissionCommand(ctx context.Context) *cobra.Command {
 			restOpts,
 			mgrOpts,
 			webhookOptions,
+			credentialsReviewOpts,
 		)
 	)
 
@@ -86,6 +91,8 @@ func NewAdmissionCommand(ctx context.Context) *cobra.Command {
 				return fmt.Errorf("error completing options: %v", err)
 			}
 
+			credentialsReviewOpts.Completed().Apply(&validator.DefaultCredentialsReviewOptions)
+
 			util.ApplyClientConnectionConfigurationToRESTConfig(&componentbaseconfig.ClientConnectionConfiguration{
 				QPS:   100.0,
 				Burst: 130,
@@ -123,6 +130,7 @@ func NewAdmissionCommand(ctx context.Context) *cobra.Command {
 			}
 
 			install.Install(mgr.GetScheme())
+			securityinstall.Install(mgr.GetScheme())
 
 			if err := ironcoreinstall.AddToScheme(mgr.GetScheme()); err != nil {
 				return fmt.Errorf("could not update manager scheme: %w", err)
@@ -170,6 +178,7 @@ func NewAdmissionCommand(ctx context.Context) *cobra.Command {
 
 	verflag.AddFlags(cmd.Flags())
 	aggOption.AddFlags(cmd.Flags())
+	features.ExtensionFeatureGate.AddFlag(cmd.Flags())
 
 	return cmd
 }
@@ -24,11 +24,13 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	autoscalingv1 "k8s.io/autoscaler/vertical-pod-autoscaler/pkg/apis/autoscaling.k8s.io/v1"
 	"k8s.io/component-base/version/verflag"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/cluster"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	"github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/apis/config"
 	ironcoreinstall "github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/apis/ironcore/install"
 	ironcorecmd "github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/cmd"
 	backupbucketcontroller "github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/controller/backupbucket"
@@ -232,6 +234,16 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 			workercontroller.DefaultAddOptions.GardenCluster = gardenCluster
 			workercontroller.DefaultAddOptions.SelfHostedShootCluster = generalConfig.SelfHostedShootCluster
 
+			ironcoreClientConfig := &config.IroncoreClientConfig{}
+			configFileOpts.Completed().ApplyIroncoreClientConfig(ironcoreClientConfig)
+			ironcore.DefaultClientManager = ironcore.NewClientManager(ironcore.ClientManagerOptions{
+				TTL:           ptr.Deref(ironcoreClientConfig.CacheTTL, metav1.Duration{}).Duration,
+				InformerCache: ironcoreClientConfig.InformerCache,
+			})
+			if err := mgr.Add(ironcore.DefaultClientManager); err != nil {
+				return fmt.Errorf("could not add ironcore client manager to manager: %w", err)
+			}
+
 			if _, err := webhookOptions.Completed().AddToManager(ctx, mgr, nil); err != nil {
 				return fmt.Errorf("could not add webhooks to manager: %w", err)
 			}
@@ -4,7 +4,10 @@
 package cmd
 
 import (
+	"time"
+
 	webhookcmd "github.com/gardener/gardener/extensions/pkg/webhook/cmd"
+	"github.com/spf13/pflag"
 
 	"github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/admission/mutator"
 	"github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/admission/validator"
@@ -18,3 +21,44 @@ func GardenWebhookSwitchOptions() *webhookcmd.SwitchOptions {
 		webhookcmd.Switch(mutator.Name, mutator.New),
 	)
 }
+
+// CredentialsReviewOptions are command line options for the review of the ironcore credentials of shoots.
+type CredentialsReviewOptions struct {
+	// Enabled enables the review of the credentials of shoots against the ironcore API server of their region.
+	Enabled bool
+	// CacheTTL is the duration for which a successful review is cached.
+	CacheTTL time.Duration
+
+	config *CredentialsReviewConfig
+}
+
+// CredentialsReviewConfig is a completed credentials review configuration.
+type CredentialsReviewConfig struct {
+	// Enabled enables the review of the credentials of shoots against the ironcore API server of their region.
+	Enabled bool
+	// CacheTTL is the duration for which a successful review is cached.
+	CacheTTL time.Duration
+}
+
+// AddFlags implements Flagger.AddFlags.
+func (o *CredentialsReviewOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.BoolVar(&o.Enabled, "credentials-review", false, "review the ironcore credentials of shoots against the API server of their region")
+	fs.DurationVar(&o.CacheTTL, "credentials-review-cache-ttl", validator.DefaultCredentialsReviewCacheTTL, "duration for which a successful credentials review is cached")
+}
+
+// Complete implements Completer.Complete.
+func (o *CredentialsReviewOptions) Complete() error {
+	o.config = &CredentialsReviewConfig{Enabled: o.Enabled, CacheTTL: o.CacheTTL}
+	return nil
+}
+
+// Completed returns the completed CredentialsReviewConfig. Only call this if `Complete` was successful.
+func (o *CredentialsReviewOptions) Completed() *CredentialsReviewConfig {
+	return o.config
+}
+
+// Apply sets the values of this CredentialsReviewConfig in the given validator.CredentialsReviewOptions.
+func (c *CredentialsReviewConfig) Apply(opts *validator.CredentialsReviewOptions) {
+	opts.Enabled = c.Enabled
+	opts.CacheTTL = c.CacheTTL
+}
@@ -29,3 +29,23 @@ func DecodeInfrastructureConfig(decoder runtime.Decoder, infra *runtime.RawExten
 
 	return infraConfig, nil
 }
+
+// DecodeBackupBucketConfig decodes the `BackupBucketConfig` from the given `RawExtension`.
+func DecodeBackupBucketConfig(decoder runtime.Decoder, config *runtime.RawExtension) (*ironcore.BackupBucketConfig, error) {
+	backupBucketConfig := &ironcore.BackupBucketConfig{}
+	if err := util.Decode(decoder, config.Raw, backupBucketConfig); err != nil {
+		return nil, err
+	}
+
+	return backupBucketConfig, nil
+}
+
+// DecodeWorkerConfig decodes the `WorkerConfig` from the given `RawExtension`.
+func DecodeWorkerConfig(decoder runtime.Decoder, worker *runtime.RawExtension) (*ironcore.WorkerConfig, error) {
+	workerConfig := &ironcore.WorkerConfig{}
+	if err := util.Decode(decoder, worker.Raw, workerConfig); err != nil {
+		return nil, err
+	}
+
+	return workerConfig, nil
+}
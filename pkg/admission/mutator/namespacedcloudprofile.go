@@ -57,6 +57,8 @@ func (p *namespacedCloudProfile) Mutate(_ context.Context, newObj, _ client.Obje
 	}
 
 	statusConfig.MachineImages = mergeMachineImages(specConfig.MachineImages, statusConfig.MachineImages)
+	statusConfig.RegionConfigs = mergeRegionConfigs(specConfig.RegionConfigs, statusConfig.RegionConfigs)
+	statusConfig.StorageClasses = mergeStorageClasses(specConfig.StorageClasses, statusConfig.StorageClasses)
 
 	modifiedStatusConfig, err := json.Marshal(statusConfig)
 	if err != nil {
@@ -88,3 +90,31 @@ func mergeMachineImages(specMachineImages, statusMachineImages []v1alpha1.Machin
 	}
 	return slices.Collect(maps.Values(statusImages))
 }
+
+// mergeRegionConfigs replaces the region configs of the status with the region configs of the same name in the spec.
+func mergeRegionConfigs(specRegionConfigs, statusRegionConfigs []v1alpha1.RegionConfig) []v1alpha1.RegionConfig {
+	return mergeByName(specRegionConfigs, statusRegionConfigs, func(rc v1alpha1.RegionConfig) string { return rc.Name })
+}
+
+// mergeStorageClasses adds the storage classes of the spec to the storage classes of the status. The default storage
+// class of the spec is only used if the status does not define one.
+func mergeStorageClasses(specStorageClasses, statusStorageClasses v1alpha1.StorageClasses) v1alpha1.StorageClasses {
+	if statusStorageClasses.Default == nil {
+		statusStorageClasses.Default = specStorageClasses.Default
+	}
+	statusStorageClasses.Additional = mergeByName(specStorageClasses.Additional, statusStorageClasses.Additional, func(sc v1alpha1.StorageClass) string { return sc.Name })
+	return statusStorageClasses
+}
+
+// mergeByName replaces the status items with the spec items of the same name and appends the remaining spec items.
+func mergeByName[T any](specItems, statusItems []T, name func(T) string) []T {
+	merged := slices.Clone(statusItems)
+	for _, specItem := range specItems {
+		if i := slices.IndexFunc(merged, func(item T) bool { return name(item) == name(specItem) }); i >= 0 {
+			merged[i] = specItem
+		} else {
+			merged = append(merged, specItem)
+		}
+	}
+	return merged
+}
@@ -81,14 +81,14 @@ var _ = Describe("NamespacedCloudProfile Mutator", func() {
 "apiVersion":"ironcore.provider.extensions.gardener.cloud/v1alpha1",
 "kind":"CloudProfileConfig",
 "machineImages":[
-  {"name":"image-1","versions":[{"version":"1.0","regions":[{"name":"eu1","ami":"ami-123"}]}]}
+  {"name":"image-1","versions":[{"version":"1.0","architecture":"amd64"}]}
 ]}`)}
 				namespacedCloudProfile.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{
 "apiVersion":"ironcore.provider.extensions.gardener.cloud/v1alpha1",
 "kind":"CloudProfileConfig",
 "machineImages":[
-  {"name":"image-1","versions":[{"version":"1.1","regions":[{"name":"eu2","ami":"ami-124","architecture":"armhf"}]}]},
-  {"name":"image-2","versions":[{"version":"2.0","regions":[{"name":"eu3","ami":"ami-125"}]}]}
+  {"name":"image-1","versions":[{"version":"1.1","architecture":"armhf"}]},
+  {"name":"image-2","versions":[{"version":"2.0","architecture":"amd64"}]}
 ]}`)}
 
 				Expect(namespacedCloudProfileMutator.Mutate(ctx, namespacedCloudProfile, nil)).To(Succeed())
@@ -109,6 +109,40 @@ var _ = Describe("NamespacedCloudProfile Mutator", func() {
 					}),
 				))
 			})
+
+			It("should correctly merge region configs and storage classes", func() {
+				namespacedCloudProfile.Status.CloudProfileSpec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{
+"apiVersion":"ironcore.provider.extensions.gardener.cloud/v1alpha1",
+"kind":"CloudProfileConfig",
+"regionConfigs":[
+  {"name":"region-a","server":"https://api.region-a.example.com"},
+  {"name":"region-b","server":"https://api.region-b.example.com"}
+],
+"storageClasses":{"additional":[{"name":"fast","type":"fast"}]}
+}`)}
+				namespacedCloudProfile.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{
+"apiVersion":"ironcore.provider.extensions.gardener.cloud/v1alpha1",
+"kind":"CloudProfileConfig",
+"regionConfigs":[{"name":"region-b","server":"https://private.region-b.example.com"}],
+"storageClasses":{"default":{"name":"default","type":"default"},"additional":[{"name":"slow","type":"slow"}]}
+}`)}
+
+				Expect(namespacedCloudProfileMutator.Mutate(ctx, namespacedCloudProfile, nil)).To(Succeed())
+
+				mergedConfig, err := decodeCloudProfileConfig(decoder, namespacedCloudProfile.Status.CloudProfileSpec.ProviderConfig)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(mergedConfig.RegionConfigs).To(Equal([]api.RegionConfig{
+					{Name: "region-a", Server: "https://api.region-a.example.com"},
+					{Name: "region-b", Server: "https://private.region-b.example.com"},
+				}))
+				Expect(mergedConfig.StorageClasses).To(Equal(api.StorageClasses{
+					Default: &api.StorageClass{Name: "default", Type: "default"},
+					Additional: []api.StorageClass{
+						{Name: "fast", Type: "fast"},
+						{Name: "slow", Type: "slow"},
+					},
+				}))
+			})
 		})
 	})
 })
@@ -0,0 +1,149 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package validator_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	networkingv1alpha1 "github.com/ironcore-dev/ironcore/api/networking/v1alpha1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+)
+
+// fakeAPIServer serves SelfSubjectAccessReviews for a single bearer token and denies the configured permissions. It
+// also serves the configured ironcore networks.
+type fakeAPIServer struct {
+	*httptest.Server
+
+	token string
+
+	mu          sync.Mutex
+	reviews     int
+	denied      map[string]bool
+	lastAttr    *authorizationv1.ResourceAttributes
+	networks    map[string]bool
+	networkGets int
+}
+
+func newFakeAPIServer(token string) *fakeAPIServer {
+	s := &fakeAPIServer{token: token, denied: map[string]bool{}, networks: map[string]bool{}}
+	s.Server = httptest.NewTLSServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+func (s *fakeAPIServer) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	if r.Header.Get("Authorization") != "Bearer "+s.token {
+		w.WriteHeader(http.StatusUnauthorized)
+		_ = json.NewEncoder(w).Encode(&metav1.Status{
+			TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Status"},
+			Status:   metav1.StatusFailure,
+			Reason:   metav1.StatusReasonUnauthorized,
+			Code:     http.StatusUnauthorized,
+		})
+		return
+	}
+	if r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/apis/networking.ironcore.dev/v1alpha1/namespaces/") {
+		s.serveNetwork(w, r)
+		return
+	}
+	if r.Method != http.MethodPost || r.URL.Path != "/apis/authorization.k8s.io/v1/selfsubjectaccessreviews" {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	// The clientset sends protobuf by default, the response is served as JSON.
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	review := &authorizationv1.SelfSubjectAccessReview{}
+	if _, _, err := clientgoscheme.Codecs.UniversalDeserializer().Decode(body, nil, review); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	review.TypeMeta = metav1.TypeMeta{APIVersion: authorizationv1.SchemeGroupVersion.String(), Kind: "SelfSubjectAccessReview"}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.reviews++
+	attr := review.Spec.ResourceAttributes
+	s.lastAttr = attr
+	review.Status.Allowed = !s.denied[fmt.Sprintf("%s %s.%s", attr.Verb, attr.Resource, attr.Group)]
+	_ = json.NewEncoder(w).Encode(review)
+}
+
+func (s *fakeAPIServer) serveNetwork(w http.ResponseWriter, r *http.Request) {
+	// The path is /apis/networking.ironcore.dev/v1alpha1/namespaces/<namespace>/networks/<name>.
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/apis/networking.ironcore.dev/v1alpha1/namespaces/"), "/")
+	if len(parts) != 3 || parts[1] != "networks" {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.networkGets++
+	if !s.networks[parts[0]+"/"+parts[2]] {
+		w.WriteHeader(http.StatusNotFound)
+		_ = json.NewEncoder(w).Encode(&metav1.Status{
+			TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Status"},
+			Status:   metav1.StatusFailure,
+			Reason:   metav1.StatusReasonNotFound,
+			Code:     http.StatusNotFound,
+		})
+		return
+	}
+	_ = json.NewEncoder(w).Encode(&networkingv1alpha1.Network{
+		TypeMeta:   metav1.TypeMeta{APIVersion: networkingv1alpha1.SchemeGroupVersion.String(), Kind: "Network"},
+		ObjectMeta: metav1.ObjectMeta{Namespace: parts[0], Name: parts[2]},
+	})
+}
+
+func (s *fakeAPIServer) addNetwork(namespace, name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.networks[namespace+"/"+name] = true
+}
+
+func (s *fakeAPIServer) networkGetCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.networkGets
+}
+
+func (s *fakeAPIServer) deny(permission string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.denied[permission] = true
+}
+
+func (s *fakeAPIServer) reviewCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.reviews
+}
+
+func (s *fakeAPIServer) allow(permission string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.denied, permission)
+}
+
+func (s *fakeAPIServer) lastReviewedNamespace() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.lastAttr == nil {
+		return ""
+	}
+	return s.lastAttr.Namespace
+}
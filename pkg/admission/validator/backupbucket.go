@@ -10,18 +10,27 @@ import (
 
 	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
 	gardencore "github.com/gardener/gardener/pkg/apis/core"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	"github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/admission"
+	apisironcore "github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/apis/ironcore"
 	ironcorevalidation "github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/apis/ironcore/validation"
 )
 
 // backupBucketValidator validates create and update operations on BackupBucket resources,
-type backupBucketValidator struct{}
+type backupBucketValidator struct {
+	decoder runtime.Decoder
+}
 
 // NewBackupBucketValidator returns a new instance of backupBucket validator.
-func NewBackupBucketValidator() extensionswebhook.Validator {
-	return &backupBucketValidator{}
+func NewBackupBucketValidator(mgr manager.Manager) extensionswebhook.Validator {
+	return &backupBucketValidator{
+		decoder: serializer.NewCodecFactory(mgr.GetScheme(), serializer.EnableStrict).UniversalDecoder(),
+	}
 }
 
 // Validate validates the BackupBucket resource during create or update operations.
@@ -31,13 +40,25 @@ func (s *backupBucketValidator) Validate(_ context.Context, newObj, _ client.Obj
 		return fmt.Errorf("wrong object type %T for object", newObj)
 	}
 
-	return s.validateBackupBucket(backupBucket).ToAggregate()
+	var backupBucketConfig *apisironcore.BackupBucketConfig
+	if backupBucket.Spec.ProviderConfig != nil {
+		var err error
+		backupBucketConfig, err = admission.DecodeBackupBucketConfig(s.decoder, backupBucket.Spec.ProviderConfig)
+		if err != nil {
+			return fmt.Errorf("error decoding providerConfig: %v", err)
+		}
+	}
+
+	return s.validateBackupBucket(backupBucket, backupBucketConfig).ToAggregate()
 }
 
 // validateBackupBucket validates the BackupBucket object.
-func (b *backupBucketValidator) validateBackupBucket(backupBucket *gardencore.BackupBucket) field.ErrorList {
+func (b *backupBucketValidator) validateBackupBucket(backupBucket *gardencore.BackupBucket, backupBucketConfig *apisironcore.BackupBucketConfig) field.ErrorList {
 	allErrs := field.ErrorList{}
 	allErrs = append(allErrs, ironcorevalidation.ValidateBackupBucketCredentialsRef(backupBucket.Spec.CredentialsRef, field.NewPath("spec", "credentialsRef"))...)
+	if backupBucketConfig != nil {
+		allErrs = append(allErrs, ironcorevalidation.ValidateBackupBucketConfig(backupBucketConfig, backupBucket.Spec.Provider.Region, field.NewPath("spec", "providerConfig"))...)
+	}
 
 	return allErrs
 }
@@ -9,11 +9,14 @@ import (
 
 	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
 	gardencore "github.com/gardener/gardener/pkg/apis/core"
+	"github.com/gardener/gardener/pkg/utils/test"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 
 	"github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/admission/validator"
+	"github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/apis/ironcore/install"
 )
 
 var _ = Describe("BackupBucket Validator", func() {
@@ -34,7 +37,9 @@ var _ = Describe("BackupBucket Validator", func() {
 				Namespace:  "garden",
 			}
 
-			backupBucketValidator = validator.NewBackupBucketValidator()
+			scheme := runtime.NewScheme()
+			Expect(install.AddToScheme(scheme)).To(Succeed())
+			backupBucketValidator = validator.NewBackupBucketValidator(&test.FakeManager{Scheme: scheme})
 		})
 
 		It("should return err when obj is not a gardencore.BackupBucket", func() {
@@ -52,5 +57,48 @@ var _ = Describe("BackupBucket Validator", func() {
 
 			Expect(backupBucketValidator.Validate(ctx, backupBucket, nil)).To(Succeed())
 		})
+
+		It("should succeed when BackupBucket is created with a valid replication config", func() {
+			backupBucket := &gardencore.BackupBucket{
+				Spec: gardencore.BackupBucketSpec{
+					Provider:       gardencore.BackupBucketProvider{Type: "ironcore", Region: "region-a"},
+					CredentialsRef: credentialsRef,
+					ProviderConfig: &runtime.RawExtension{Raw: []byte(`{
+"apiVersion": "ironcore.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "BackupBucketConfig",
+"replication": {"region": "region-b", "secretRef": {"name": "backup-region-b", "namespace": "garden"}}
+}`)},
+				},
+			}
+
+			Expect(backupBucketValidator.Validate(ctx, backupBucket, nil)).To(Succeed())
+		})
+
+		It("should fail when the replication targets the primary region", func() {
+			backupBucket := &gardencore.BackupBucket{
+				Spec: gardencore.BackupBucketSpec{
+					Provider:       gardencore.BackupBucketProvider{Type: "ironcore", Region: "region-a"},
+					CredentialsRef: credentialsRef,
+					ProviderConfig: &runtime.RawExtension{Raw: []byte(`{
+"apiVersion": "ironcore.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "BackupBucketConfig",
+"replication": {"region": "region-a", "secretRef": {"name": "backup-region-b", "namespace": "garden"}}
+}`)},
+				},
+			}
+
+			Expect(backupBucketValidator.Validate(ctx, backupBucket, nil)).To(MatchError(ContainSubstring("spec.providerConfig.replication.region")))
+		})
+
+		It("should fail when the providerConfig cannot be decoded", func() {
+			backupBucket := &gardencore.BackupBucket{
+				Spec: gardencore.BackupBucketSpec{
+					CredentialsRef: credentialsRef,
+					ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"apiVersion": "ironcore.provider.extensions.gardener.cloud/v1alpha1", "kind": "BackupBucketConfig", "foo": "bar"}`)},
+				},
+			}
+
+			Expect(backupBucketValidator.Validate(ctx, backupBucket, nil)).To(MatchError(ContainSubstring("error decoding providerConfig")))
+		})
 	})
 })
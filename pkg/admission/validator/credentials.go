@@ -0,0 +1,266 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package validator
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	ipamv1alpha1 "github.com/ironcore-dev/ironcore/api/ipam/v1alpha1"
+	networkingv1alpha1 "github.com/ironcore-dev/ironcore/api/networking/v1alpha1"
+	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+
+	apisironcore "github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/apis/ironcore"
+	ironcorevalidation "github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/apis/ironcore/validation"
+	"github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/ironcore"
+)
+
+// DefaultCredentialsReviewCacheTTL is the default duration for which a successful credentials review is cached.
+const DefaultCredentialsReviewCacheTTL = 10 * time.Minute
+
+// CredentialsReviewOptions are the options of the review of the ironcore credentials of shoots in the shoot validator.
+type CredentialsReviewOptions struct {
+	// Enabled enables the review of the credentials of shoots against the ironcore API server of their region.
+	Enabled bool
+	// CacheTTL is the duration for which a successful review is cached. Defaults to DefaultCredentialsReviewCacheTTL.
+	CacheTTL time.Duration
+}
+
+// DefaultCredentialsReviewOptions are the CredentialsReviewOptions used by the shoot validator.
+var DefaultCredentialsReviewOptions = CredentialsReviewOptions{}
+
+// requiredPermissions are the verbs the extension and the machine-controller-manager need on the ironcore resources
+// they manage in the namespace of the credentials.
+var requiredPermissions = func() []authorizationv1.ResourceAttributes {
+	var (
+		verbs     = []string{"get", "list", "watch", "create", "patch", "delete"}
+		resources = []struct{ group, resource string }{
+			{networkingv1alpha1.SchemeGroupVersion.Group, "networks"},
+			{networkingv1alpha1.SchemeGroupVersion.Group, "natgateways"},
+			{ipamv1alpha1.SchemeGroupVersion.Group, "prefixes"},
+			{computev1alpha1.SchemeGroupVersion.Group, "machines"},
+			{storagev1alpha1.SchemeGroupVersion.Group, "buckets"},
+		}
+		permissions []authorizationv1.ResourceAttributes
+	)
+	for _, resource := range resources {
+		for _, verb := range verbs {
+			permissions = append(permissions, authorizationv1.ResourceAttributes{
+				Group:    resource.group,
+				Resource: resource.resource,
+				Verb:     verb,
+			})
+		}
+	}
+	return permissions
+}()
+
+// credentialsReviewer checks whether the credentials of a secret are accepted by the ironcore API server of a region
+// and grant the required permissions in the namespace of the secret. Only successful reviews are cached, so that
+// credentials are accepted as soon as their permissions are fixed.
+type credentialsReviewer struct {
+	opts  CredentialsReviewOptions
+	clock clock.PassiveClock
+
+	mu       sync.Mutex
+	reviewed map[string]time.Time
+}
+
+// newCredentialsReviewer returns a credentialsReviewer for the given options, or nil if the review is disabled.
+func newCredentialsReviewer(opts CredentialsReviewOptions) *credentialsReviewer {
+	if !opts.Enabled {
+		return nil
+	}
+	if opts.CacheTTL <= 0 {
+		opts.CacheTTL = DefaultCredentialsReviewCacheTTL
+	}
+	return &credentialsReviewer{
+		opts:     opts,
+		clock:    clock.RealClock{},
+		reviewed: make(map[string]time.Time),
+	}
+}
+
+// Review returns the reason why the credentials of the given secret are denied by the given region, or an empty string
+// if they grant all required permissions. A nil reviewer accepts all credentials. An error is returned if the review
+// could not be completed.
+func (r *credentialsReviewer) Review(ctx context.Context, secret *corev1.Secret, region *apisironcore.RegionConfig) (string, error) {
+	if r == nil {
+		return "", nil
+	}
+
+	key := r.cacheKey(secret, region)
+	r.mu.Lock()
+	r.evictExpiredLocked()
+	_, ok := r.reviewed[key]
+	r.mu.Unlock()
+	if ok {
+		return "", nil
+	}
+
+	denial, err := r.review(ctx, secret, region)
+	if err != nil || denial != "" {
+		return denial, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.reviewed[key] = r.clock.Now()
+	return "", nil
+}
+
+// review reviews the credentials of the given secret against the servers of the given region.
+func (r *credentialsReviewer) review(ctx context.Context, secret *corev1.Secret, region *apisironcore.RegionConfig) (string, error) {
+	restConfig, err := restConfigForRegion(secret, region)
+	if err != nil {
+		return err.Error(), nil
+	}
+
+	var denial string
+	err = forEachRegionServer(ctx, restConfig, region, func(restConfig *rest.Config) error {
+		var reviewErr error
+		denial, reviewErr = reviewPermissions(ctx, restConfig, string(secret.Data[ironcore.NamespaceFieldName]), region)
+		return reviewErr
+	})
+	return denial, err
+}
+
+// reviewPermissions returns the reason why the credentials of the given rest config are denied, or an empty string if
+// they grant all required permissions in the given namespace.
+func reviewPermissions(ctx context.Context, restConfig *rest.Config, namespace string, region *apisironcore.RegionConfig) (string, error) {
+	clientset, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return "", fmt.Errorf("failed to create client for region %s: %w", region.Name, err)
+	}
+
+	var missing []string
+	for _, permission := range requiredPermissions {
+		permission.Namespace = namespace
+		review, err := clientset.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, &authorizationv1.SelfSubjectAccessReview{
+			Spec: authorizationv1.SelfSubjectAccessReviewSpec{ResourceAttributes: &permission},
+		}, metav1.CreateOptions{})
+		if err != nil {
+			if apierrors.IsUnauthorized(err) {
+				return fmt.Sprintf("credentials are not accepted by the ironcore API server of region %s", region.Name), nil
+			}
+			return "", fmt.Errorf("failed to review credentials against region %s: %w", region.Name, err)
+		}
+		if !review.Status.Allowed {
+			missing = append(missing, fmt.Sprintf("%s %s.%s", permission.Verb, permission.Resource, permission.Group))
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Sprintf("credentials are missing permissions in namespace %s of region %s: %s",
+			namespace, region.Name, strings.Join(missing, ", ")), nil
+	}
+	return "", nil
+}
+
+// forEachRegionServer calls f with a copy of the given rest config for the server of the given region and then for
+// each of its failover servers, until f returns without an error or with an error which was returned by the server.
+func forEachRegionServer(ctx context.Context, restConfig *rest.Config, region *apisironcore.RegionConfig, f func(*rest.Config) error) error {
+	var errs []error
+	for _, server := range append([]string{region.Server}, region.FailoverServers...) {
+		serverConfig := rest.CopyConfig(restConfig)
+		serverConfig.Host = server
+
+		err := f(serverConfig)
+		var status apierrors.APIStatus
+		if err == nil || errors.As(err, &status) || ctx.Err() != nil {
+			return err
+		}
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// restConfigForRegion returns a rest config which authenticates with the credentials of the given secret against
+// the server of the given region.
+func restConfigForRegion(secret *corev1.Secret, region *apisironcore.RegionConfig) (*rest.Config, error) {
+	restConfig := &rest.Config{
+		Host: region.Server,
+		TLSClientConfig: rest.TLSClientConfig{
+			CAData:     region.CertificateAuthorityData,
+			ServerName: ptr.Deref(region.TLSServerName, ""),
+		},
+		// All permissions are reviewed in a row, the default client side rate limit would delay the admission.
+		QPS:     50,
+		Burst:   len(requiredPermissions),
+		Timeout: 10 * time.Second,
+	}
+	if region.ProxyURL != nil {
+		proxyURL, err := url.Parse(*region.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy URL of region %s: %w", region.Name, err)
+		}
+		restConfig.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	switch {
+	case len(secret.Data[ironcore.TokenFieldName]) > 0:
+		restConfig.BearerToken = string(secret.Data[ironcore.TokenFieldName])
+	case len(secret.Data[ironcore.ClientCertificateFieldName]) > 0:
+		restConfig.CertData = secret.Data[ironcore.ClientCertificateFieldName]
+		restConfig.KeyData = secret.Data[ironcore.ClientKeyFieldName]
+	case len(secret.Data[ironcore.KubeConfigFieldName]) > 0:
+		config, err := ironcorevalidation.ValidateKubeconfig(secret.Data[ironcore.KubeConfigFieldName])
+		if err != nil {
+			return nil, fmt.Errorf("invalid field: %s in cloud provider secret: %w", ironcore.KubeConfigFieldName, err)
+		}
+		authInfo := config.AuthInfos[config.Contexts[config.CurrentContext].AuthInfo]
+		restConfig.BearerToken = authInfo.Token
+		restConfig.CertData = authInfo.ClientCertificateData
+		restConfig.KeyData = authInfo.ClientKeyData
+	default:
+		return nil, fmt.Errorf("missing credentials in cloud provider secret")
+	}
+	return restConfig, nil
+}
+
+// cacheKey identifies the credentials of the given secret in the given region. The data is hashed since the
+// resourceVersion of a secret does not change before an update is admitted.
+func (r *credentialsReviewer) cacheKey(secret *corev1.Secret, region *apisironcore.RegionConfig) string {
+	hash := sha256.New()
+	hash.Write([]byte(region.Name))
+	hash.Write([]byte{0})
+	for _, key := range []string{
+		ironcore.NamespaceFieldName,
+		ironcore.TokenFieldName,
+		ironcore.ClientCertificateFieldName,
+		ironcore.ClientKeyFieldName,
+		ironcore.KubeConfigFieldName,
+	} {
+		hash.Write([]byte(key))
+		hash.Write([]byte{0})
+		hash.Write(secret.Data[key])
+		hash.Write([]byte{0})
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+func (r *credentialsReviewer) evictExpiredLocked() {
+	now := r.clock.Now()
+	for key, reviewed := range r.reviewed {
+		if now.Sub(reviewed) >= r.opts.CacheTTL {
+			delete(r.reviewed, key)
+		}
+	}
+}
@@ -7,27 +7,37 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/gardener/gardener/extensions/pkg/util"
 	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
 	"github.com/gardener/gardener/pkg/apis/security"
+	securityv1alpha1 "github.com/gardener/gardener/pkg/apis/security/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	apisironcore "github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/apis/ironcore"
 	ironcorevalidation "github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/apis/ironcore/validation"
+	"github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/ironcore"
 )
 
 type credentialsBinding struct {
 	apiReader client.Reader
+	decoder   runtime.Decoder
 }
 
 // NewCredentialsBindingValidator returns a new instance of a credentials binding validator.
 func NewCredentialsBindingValidator(mgr manager.Manager) extensionswebhook.Validator {
 	return &credentialsBinding{
 		apiReader: mgr.GetAPIReader(),
+		decoder:   serializer.NewCodecFactory(mgr.GetScheme(), serializer.EnableStrict).UniversalDecoder(),
 	}
 }
 
-// Validate checks whether the given CredentialsBinding refers to a Secret with a valid ironcore service account.
+// Validate checks whether the given CredentialsBinding refers to a Secret with valid ironcore credentials or to a
+// WorkloadIdentity for ironcore.
 func (cb *credentialsBinding) Validate(ctx context.Context, newObj, oldObj client.Object) error {
 	credentialsBinding, ok := newObj.(*security.CredentialsBinding)
 	if !ok {
@@ -57,7 +67,31 @@ func (cb *credentialsBinding) Validate(ctx context.Context, newObj, oldObj clien
 		}
 
 		return ironcorevalidation.ValidateCloudProviderSecret(secret)
+	case credentialsBinding.CredentialsRef.APIVersion == securityv1alpha1.SchemeGroupVersion.String() && credentialsBinding.CredentialsRef.Kind == "WorkloadIdentity":
+		workloadIdentity := &securityv1alpha1.WorkloadIdentity{}
+		if err := cb.apiReader.Get(ctx, credentialsKey, workloadIdentity); err != nil {
+			return err
+		}
+
+		return cb.validateWorkloadIdentity(workloadIdentity)
 	default:
 		return fmt.Errorf("unsupported credentials reference: version %q, kind %q", credentialsBinding.CredentialsRef.APIVersion, credentialsBinding.CredentialsRef.Kind)
 	}
 }
+
+func (cb *credentialsBinding) validateWorkloadIdentity(workloadIdentity *securityv1alpha1.WorkloadIdentity) error {
+	if workloadIdentity.Spec.TargetSystem.Type != ironcore.Type {
+		return fmt.Errorf("workload identity %s has target system type %q, expected %q",
+			client.ObjectKeyFromObject(workloadIdentity), workloadIdentity.Spec.TargetSystem.Type, ironcore.Type)
+	}
+	if workloadIdentity.Spec.TargetSystem.ProviderConfig == nil {
+		return fmt.Errorf("workload identity %s has no provider config", client.ObjectKeyFromObject(workloadIdentity))
+	}
+
+	config := &apisironcore.WorkloadIdentityConfig{}
+	if err := util.Decode(cb.decoder, workloadIdentity.Spec.TargetSystem.ProviderConfig.Raw, config); err != nil {
+		return fmt.Errorf("could not decode provider config of workload identity %s: %w", client.ObjectKeyFromObject(workloadIdentity), err)
+	}
+
+	return ironcorevalidation.ValidateWorkloadIdentityConfig(config, field.NewPath("spec", "targetSystem", "providerConfig")).ToAggregate()
+}
@@ -9,6 +9,7 @@ import (
 
 	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
 	"github.com/gardener/gardener/pkg/apis/security"
+	securityv1alpha1 "github.com/gardener/gardener/pkg/apis/security/v1alpha1"
 	"github.com/gardener/gardener/pkg/utils/test"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -19,6 +20,7 @@ import (
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/admission/validator"
+	"github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/apis/ironcore/install"
 )
 
 var _ = Describe("CredentialsBinding validator", func() {
@@ -35,6 +37,8 @@ var _ = Describe("CredentialsBinding validator", func() {
 			scheme = func() *runtime.Scheme {
 				s := runtime.NewScheme()
 				Expect(corev1.AddToScheme(s)).To(Succeed())
+				Expect(securityv1alpha1.AddToScheme(s)).To(Succeed())
+				Expect(install.AddToScheme(s)).To(Succeed())
 				return s
 			}()
 		)
@@ -52,7 +56,7 @@ var _ = Describe("CredentialsBinding validator", func() {
 
 		newValidator := func(objects ...client.Object) extensionswebhook.Validator {
 			apiReader := fakeclient.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
-			mgr := &test.FakeManager{APIReader: apiReader}
+			mgr := &test.FakeManager{APIReader: apiReader, Scheme: scheme}
 			return validator.NewCredentialsBindingValidator(mgr)
 		}
 
@@ -85,7 +89,7 @@ var _ = Describe("CredentialsBinding validator", func() {
 				},
 			}
 			err := newValidator(secret).Validate(ctx, credentialsBinding, nil)
-			Expect(err).To(MatchError("missing field: token in cloud provider secret"))
+			Expect(err).To(MatchError(ContainSubstring("missing credentials in cloud provider secret")))
 		})
 
 		It("should succeed when the corresponding Secret is valid", func() {
@@ -100,6 +104,51 @@ var _ = Describe("CredentialsBinding validator", func() {
 			Expect(newValidator(secret).Validate(ctx, credentialsBinding, nil)).To(Succeed())
 		})
 
+		Context("workload identity", func() {
+			var workloadIdentity *securityv1alpha1.WorkloadIdentity
+
+			BeforeEach(func() {
+				credentialsBinding.CredentialsRef.APIVersion = "security.gardener.cloud/v1alpha1"
+				credentialsBinding.CredentialsRef.Kind = "WorkloadIdentity"
+
+				workloadIdentity = &securityv1alpha1.WorkloadIdentity{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+					Spec: securityv1alpha1.WorkloadIdentitySpec{
+						Audiences: []string{"ironcore"},
+						TargetSystem: securityv1alpha1.TargetSystem{
+							Type: "ironcore",
+							ProviderConfig: &runtime.RawExtension{
+								Raw: []byte(`{"apiVersion":"ironcore.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkloadIdentityConfig","namespace":"default"}`),
+							},
+						},
+					},
+				}
+			})
+
+			It("should succeed when the corresponding WorkloadIdentity is valid", func() {
+				Expect(newValidator(workloadIdentity).Validate(ctx, credentialsBinding, nil)).To(Succeed())
+			})
+
+			It("should return err if the WorkloadIdentity does not exist", func() {
+				Expect(newValidator().Validate(ctx, credentialsBinding, nil)).To(HaveOccurred())
+			})
+
+			It("should return err if the WorkloadIdentity targets another system", func() {
+				workloadIdentity.Spec.TargetSystem.Type = "aws"
+				Expect(newValidator(workloadIdentity).Validate(ctx, credentialsBinding, nil)).To(MatchError(ContainSubstring(`has target system type "aws"`)))
+			})
+
+			It("should return err if the WorkloadIdentity has no provider config", func() {
+				workloadIdentity.Spec.TargetSystem.ProviderConfig = nil
+				Expect(newValidator(workloadIdentity).Validate(ctx, credentialsBinding, nil)).To(MatchError(ContainSubstring("has no provider config")))
+			})
+
+			It("should return err if the WorkloadIdentity provider config is invalid", func() {
+				workloadIdentity.Spec.TargetSystem.ProviderConfig.Raw = []byte(`{"apiVersion":"ironcore.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkloadIdentityConfig","namespace":"%invalid"}`)
+				Expect(newValidator(workloadIdentity).Validate(ctx, credentialsBinding, nil)).To(MatchError(ContainSubstring("spec.targetSystem.providerConfig.namespace")))
+			})
+		})
+
 		It("should return nil when the CredentialsBinding did not change", func() {
 			old := credentialsBinding.DeepCopy()
 			Expect(newValidator().Validate(ctx, credentialsBinding, old)).To(Succeed())
@@ -15,6 +15,7 @@ import (
 	gutil "github.com/gardener/gardener/pkg/utils/gardener"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -66,14 +67,75 @@ func (p *namespacedCloudProfile) Validate(ctx context.Context, newObj, _ client.
 		return err
 	}
 
-	return p.validateNamespacedCloudProfileProviderConfig(cpConfig, profile.Spec, parentProfile.Spec).ToAggregate()
+	parentConfig := &api.CloudProfileConfig{}
+	if parentProfile.Spec.ProviderConfig != nil {
+		var err error
+		parentConfig, err = decodeCloudProfileConfig(p.decoder, parentProfile.Spec.ProviderConfig)
+		if err != nil {
+			return fmt.Errorf("could not decode providerConfig of parent CloudProfile %s: %w", parentProfile.Name, err)
+		}
+	}
+
+	return p.validateNamespacedCloudProfileProviderConfig(cpConfig, profile.Spec, parentProfile.Spec, parentConfig).ToAggregate()
 }
 
 // validateNamespacedCloudProfileProviderConfig validates the CloudProfileConfig passed with a NamespacedCloudProfile.
-func (p *namespacedCloudProfile) validateNamespacedCloudProfileProviderConfig(providerConfig *api.CloudProfileConfig, profileSpec core.NamespacedCloudProfileSpec, parentSpec gardencorev1beta1.CloudProfileSpec) field.ErrorList {
+func (p *namespacedCloudProfile) validateNamespacedCloudProfileProviderConfig(providerConfig *api.CloudProfileConfig, profileSpec core.NamespacedCloudProfileSpec, parentSpec gardencorev1beta1.CloudProfileSpec, parentConfig *api.CloudProfileConfig) field.ErrorList {
 	allErrs := field.ErrorList{}
 
 	allErrs = append(allErrs, p.validateMachineImages(providerConfig, profileSpec.MachineImages, parentSpec)...)
+	allErrs = append(allErrs, p.validateRegionConfigs(providerConfig.RegionConfigs, parentSpec)...)
+	allErrs = append(allErrs, p.validateStorageClasses(providerConfig.StorageClasses, parentConfig.StorageClasses)...)
+
+	return allErrs
+}
+
+// validateRegionConfigs validates the RegionConfigs of a NamespacedCloudProfile. They replace the RegionConfigs of the
+// parent CloudProfile with the same name and can only be defined for regions of the parent CloudProfile.
+func (p *namespacedCloudProfile) validateRegionConfigs(regionConfigs []api.RegionConfig, parentSpec gardencorev1beta1.CloudProfileSpec) field.ErrorList {
+	regionConfigsPath := field.NewPath("spec.providerConfig.regionConfigs")
+	allErrs := validation.ValidateRegionConfigs(regionConfigs, regionConfigsPath)
+
+	parentRegions := sets.New[string]()
+	for _, region := range parentSpec.Regions {
+		parentRegions.Insert(region.Name)
+	}
+	for i, regionConfig := range regionConfigs {
+		if len(regionConfig.Name) > 0 && !parentRegions.Has(regionConfig.Name) {
+			allErrs = append(allErrs, field.NotSupported(regionConfigsPath.Index(i).Child("name"), regionConfig.Name, sets.List(parentRegions)))
+		}
+	}
+
+	return allErrs
+}
+
+// validateStorageClasses validates the StorageClasses of a NamespacedCloudProfile. They are added to the StorageClasses
+// of the parent CloudProfile and must not redefine them.
+func (p *namespacedCloudProfile) validateStorageClasses(storageClasses, parentStorageClasses api.StorageClasses) field.ErrorList {
+	storageClassesPath := field.NewPath("spec.providerConfig.storageClasses")
+	allErrs := validation.ValidateStorageClasses(storageClasses, storageClassesPath)
+
+	parentNames := sets.New[string]()
+	if parentStorageClasses.Default != nil {
+		parentNames.Insert(parentStorageClasses.Default.Name)
+	}
+	for _, sc := range parentStorageClasses.Additional {
+		parentNames.Insert(sc.Name)
+	}
+
+	if storageClasses.Default != nil {
+		defaultPath := storageClassesPath.Child("defaultStorageClasses")
+		if parentStorageClasses.Default != nil {
+			allErrs = append(allErrs, field.Forbidden(defaultPath, "default storage class is already defined in the parent CloudProfile"))
+		} else if parentNames.Has(storageClasses.Default.Name) {
+			allErrs = append(allErrs, field.Forbidden(defaultPath.Child("name"), fmt.Sprintf("storage class %s is already defined in the parent CloudProfile", storageClasses.Default.Name)))
+		}
+	}
+	for i, sc := range storageClasses.Additional {
+		if parentNames.Has(sc.Name) {
+			allErrs = append(allErrs, field.Forbidden(storageClassesPath.Child("additionalStorageClasses").Index(i).Child("name"), fmt.Sprintf("storage class %s is already defined in the parent CloudProfile", sc.Name)))
+		}
+	}
 
 	return allErrs
 }
@@ -134,6 +134,76 @@ var _ = Describe("NamespacedCloudProfile Validator", func() {
 			Expect(namespacedCloudProfileValidator.Validate(ctx, namespacedCloudProfile, nil)).To(Succeed())
 		})
 
+		It("should succeed if the NamespacedCloudProfile defines region configs and additional storage classes", func() {
+			cloudProfile.Spec.Regions = []v1beta1.Region{{Name: "region-a"}}
+			cloudProfile.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{
+"apiVersion":"ironcore.provider.extensions.gardener.cloud/v1alpha1",
+"kind":"CloudProfileConfig",
+"regionConfigs":[{"name":"region-a","server":"https://api.region-a.example.com"}],
+"storageClasses":{"default":{"name":"default","type":"fast"}}
+}`)}
+			namespacedCloudProfile.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{
+"apiVersion":"ironcore.provider.extensions.gardener.cloud/v1alpha1",
+"kind":"CloudProfileConfig",
+"regionConfigs":[{"name":"region-a","server":"https://private.region-a.example.com"}],
+"storageClasses":{"additional":[{"name":"slow","type":"slow"}]}
+}`)}
+			Expect(fakeClient.Create(ctx, cloudProfile)).To(Succeed())
+
+			Expect(namespacedCloudProfileValidator.Validate(ctx, namespacedCloudProfile, nil)).To(Succeed())
+		})
+
+		It("should fail for NamespacedCloudProfile defining invalid region configs or regions unknown to the parent CloudProfile", func() {
+			cloudProfile.Spec.Regions = []v1beta1.Region{{Name: "region-a"}}
+			namespacedCloudProfile.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{
+"apiVersion":"ironcore.provider.extensions.gardener.cloud/v1alpha1",
+"kind":"CloudProfileConfig",
+"regionConfigs":[
+  {"name":"region-a","server":"http://private.region-a.example.com"},
+  {"name":"region-b","server":"https://api.region-b.example.com"}
+]
+}`)}
+			Expect(fakeClient.Create(ctx, cloudProfile)).To(Succeed())
+
+			err := namespacedCloudProfileValidator.Validate(ctx, namespacedCloudProfile, nil)
+			Expect(err).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.providerConfig.regionConfigs[0].server"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":     Equal(field.ErrorTypeNotSupported),
+				"Field":    Equal("spec.providerConfig.regionConfigs[1].name"),
+				"BadValue": Equal("region-b"),
+			}))))
+		})
+
+		It("should fail for NamespacedCloudProfile redefining storage classes of the parent CloudProfile", func() {
+			cloudProfile.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{
+"apiVersion":"ironcore.provider.extensions.gardener.cloud/v1alpha1",
+"kind":"CloudProfileConfig",
+"storageClasses":{"default":{"name":"default","type":"fast"},"additional":[{"name":"slow","type":"slow"}]}
+}`)}
+			namespacedCloudProfile.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{
+"apiVersion":"ironcore.provider.extensions.gardener.cloud/v1alpha1",
+"kind":"CloudProfileConfig",
+"storageClasses":{"default":{"name":"other","type":"fast"},"additional":[{"name":"default","type":"slow"},{"name":"Invalid_Name","type":"slow"}]}
+}`)}
+			Expect(fakeClient.Create(ctx, cloudProfile)).To(Succeed())
+
+			err := namespacedCloudProfileValidator.Validate(ctx, namespacedCloudProfile, nil)
+			Expect(err).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeForbidden),
+				"Field":  Equal("spec.providerConfig.storageClasses.defaultStorageClasses"),
+				"Detail": Equal("default storage class is already defined in the parent CloudProfile"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeForbidden),
+				"Field":  Equal("spec.providerConfig.storageClasses.additionalStorageClasses[0].name"),
+				"Detail": Equal("storage class default is already defined in the parent CloudProfile"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.providerConfig.storageClasses.additionalStorageClasses[1].name"),
+			}))))
+		})
+
 		It("should fail for NamespacedCloudProfile with invalid parent kind", func() {
 			namespacedCloudProfile.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{
 "apiVersion":"ironcore.provider.extensions.gardener.cloud/v1alpha1",
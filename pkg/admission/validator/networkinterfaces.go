@@ -0,0 +1,234 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package validator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	securityv1alpha1 "github.com/gardener/gardener/pkg/apis/security/v1alpha1"
+	networkingv1alpha1 "github.com/ironcore-dev/ironcore/api/networking/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/rest"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	apisironcore "github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/apis/ironcore"
+	"github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/features"
+	"github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/ironcore"
+)
+
+var (
+	networkScheme = runtime.NewScheme()
+	// networkRESTMapper maps the ironcore Network statically, so that a lookup does not need a discovery round trip.
+	networkRESTMapper = meta.NewDefaultRESTMapper([]schema.GroupVersion{networkingv1alpha1.SchemeGroupVersion})
+)
+
+func init() {
+	utilruntime.Must(networkingv1alpha1.AddToScheme(networkScheme))
+	networkRESTMapper.Add(networkingv1alpha1.SchemeGroupVersion.WithKind("Network"), meta.RESTScopeNamespace)
+}
+
+// networkInterfaceRef is an additional network interface of a worker whose network has to be looked up.
+type networkInterfaceRef struct {
+	path        *field.Path
+	networkName string
+}
+
+// validateNetworkInterfaces checks that the additional network interfaces of the workers are not attached to the
+// network of the shoot, which every machine is already attached to. Network interfaces can only be added or changed if
+// the NetworkInterfaces feature gate is enabled. The networks of network interfaces which are added by the given shoot
+// have to exist in the ironcore namespace of the shoot credentials. This check is skipped if the CloudProfile has no
+// region config for the region of the shoot or if the shoot does not use a credentials secret.
+func (s *shoot) validateNetworkInterfaces(ctx context.Context, valContext, oldValContext *validationContext) (field.ErrorList, error) {
+	var (
+		allErrs              = field.ErrorList{}
+		shoot                = valContext.shoot
+		shootNetworkName     = shootNetworkName(shoot, valContext.infrastructureConfig)
+		oldNetworkInterfaces = map[string][]apisironcore.NetworkInterfaceConfig{}
+		added                []networkInterfaceRef
+	)
+
+	if oldValContext != nil {
+		for i, workerConfig := range oldValContext.workerConfigs {
+			if workerConfig != nil {
+				oldNetworkInterfaces[oldValContext.shoot.Spec.Provider.Workers[i].Name] = workerConfig.NetworkInterfaces
+			}
+		}
+	}
+
+	for i, workerConfig := range valContext.workerConfigs {
+		if workerConfig == nil || len(workerConfig.NetworkInterfaces) == 0 {
+			continue
+		}
+		worker := shoot.Spec.Provider.Workers[i]
+		if !features.ExtensionFeatureGate.Enabled(features.NetworkInterfaces) &&
+			!apiequality.Semantic.DeepEqual(workerConfig.NetworkInterfaces, oldNetworkInterfaces[worker.Name]) {
+			allErrs = append(allErrs, field.Forbidden(workersPath.Index(i).Child("providerConfig", "networkInterfaces"),
+				fmt.Sprintf("network interfaces are not supported, the %s feature gate is disabled", features.NetworkInterfaces)))
+			continue
+		}
+
+		oldNetworkNames := sets.New[string]()
+		for _, networkInterface := range oldNetworkInterfaces[worker.Name] {
+			oldNetworkNames.Insert(networkInterface.NetworkName)
+		}
+		for j, networkInterface := range workerConfig.NetworkInterfaces {
+			networkNamePath := workersPath.Index(i).Child("providerConfig", "networkInterfaces").Index(j).Child("networkName")
+			if networkInterface.NetworkName == shootNetworkName {
+				allErrs = append(allErrs, field.Invalid(networkNamePath, networkInterface.NetworkName, "must not be the network of the shoot"))
+				continue
+			}
+			if !oldNetworkNames.Has(networkInterface.NetworkName) {
+				added = append(added, networkInterfaceRef{path: networkNamePath, networkName: networkInterface.NetworkName})
+			}
+		}
+	}
+
+	if len(added) == 0 || shoot.DeletionTimestamp != nil {
+		return allErrs, nil
+	}
+
+	region := shootRegionConfig(valContext)
+	if region == nil {
+		return allErrs, nil
+	}
+	secret, err := s.getCredentialsSecret(ctx, shoot)
+	if err != nil || secret == nil {
+		return allErrs, err
+	}
+	restConfig, err := restConfigForRegion(secret, region)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		namespace    = string(secret.Data[ironcore.NamespaceFieldName])
+		notFoundErrs field.ErrorList
+	)
+	if err := forEachRegionServer(ctx, restConfig, region, func(restConfig *rest.Config) error {
+		ironcoreClient, err := client.New(restConfig, client.Options{Scheme: networkScheme, Mapper: networkRESTMapper})
+		if err != nil {
+			return fmt.Errorf("failed to create client for region %s: %w", region.Name, err)
+		}
+
+		notFoundErrs = nil
+		for _, ref := range added {
+			network := &networkingv1alpha1.Network{}
+			networkKey := client.ObjectKey{Namespace: namespace, Name: ref.networkName}
+			if err := ironcoreClient.Get(ctx, networkKey, network); err != nil {
+				if apierrors.IsNotFound(err) {
+					notFoundErrs = append(notFoundErrs, field.NotFound(ref.path, ref.networkName))
+					continue
+				}
+				return fmt.Errorf("failed to get ironcore network %s: %w", networkKey, err)
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return append(allErrs, notFoundErrs...), nil
+}
+
+// shootNetworkName returns the name of the ironcore network of the given shoot, which is either referenced by its
+// InfrastructureConfig or created by the infrastructure controller.
+func shootNetworkName(shoot *core.Shoot, infrastructureConfig *apisironcore.InfrastructureConfig) string {
+	if infrastructureConfig != nil && infrastructureConfig.NetworkRef != nil {
+		return infrastructureConfig.NetworkRef.Name
+	}
+	return fmt.Sprintf("shoot--%s--%s", shoot.Namespace, shoot.Name)
+}
+
+// shootRegionConfig returns the region config of the region of the shoot, or nil if the CloudProfile has none.
+func shootRegionConfig(valContext *validationContext) *apisironcore.RegionConfig {
+	for _, regionConfig := range valContext.cloudProfileConfig.RegionConfigs {
+		if regionConfig.Name == valContext.shoot.Spec.Region {
+			return &regionConfig
+		}
+	}
+	return nil
+}
+
+// reviewCredentials reviews the credentials of the given shoot against the ironcore API server of its region when the
+// shoot starts to use them, i.e. on creation or if its region or the binding of its credentials changes. The review is
+// skipped if the CloudProfile has no region config for the region or the shoot does not use a credentials secret.
+func (s *shoot) reviewCredentials(ctx context.Context, valContext, oldValContext *validationContext) (field.ErrorList, error) {
+	shoot := valContext.shoot
+	if s.reviewer == nil || shoot.DeletionTimestamp != nil {
+		return nil, nil
+	}
+	if oldValContext != nil {
+		oldShoot := oldValContext.shoot
+		if oldShoot.Spec.Region == shoot.Spec.Region &&
+			ptr.Equal(oldShoot.Spec.CredentialsBindingName, shoot.Spec.CredentialsBindingName) &&
+			ptr.Equal(oldShoot.Spec.SecretBindingName, shoot.Spec.SecretBindingName) {
+			return nil, nil
+		}
+	}
+
+	region := shootRegionConfig(valContext)
+	if region == nil {
+		return nil, nil
+	}
+	secret, err := s.getCredentialsSecret(ctx, shoot)
+	if err != nil || secret == nil {
+		return nil, err
+	}
+
+	denial, err := s.reviewer.Review(ctx, secret, region)
+	if err != nil || denial == "" {
+		return nil, err
+	}
+	bindingPath := specPath.Child("credentialsBindingName")
+	if ptr.Deref(shoot.Spec.CredentialsBindingName, "") == "" {
+		bindingPath = specPath.Child("secretBindingName")
+	}
+	return field.ErrorList{field.Forbidden(bindingPath, denial)}, nil
+}
+
+// getCredentialsSecret returns the secret which the CredentialsBinding or the SecretBinding of the given shoot refers
+// to, or nil if the shoot uses a WorkloadIdentity.
+func (s *shoot) getCredentialsSecret(ctx context.Context, shoot *core.Shoot) (*corev1.Secret, error) {
+	var secretKey client.ObjectKey
+	switch {
+	case ptr.Deref(shoot.Spec.CredentialsBindingName, "") != "":
+		credentialsBinding := &securityv1alpha1.CredentialsBinding{}
+		credentialsBindingKey := client.ObjectKey{Namespace: shoot.Namespace, Name: *shoot.Spec.CredentialsBindingName}
+		if err := s.apiReader.Get(ctx, credentialsBindingKey, credentialsBinding); err != nil {
+			return nil, fmt.Errorf("failed to get credentials binding %s: %w", credentialsBindingKey, err)
+		}
+		credentialsRef := credentialsBinding.CredentialsRef
+		if credentialsRef.APIVersion != corev1.SchemeGroupVersion.String() || credentialsRef.Kind != "Secret" {
+			return nil, nil
+		}
+		secretKey = client.ObjectKey{Namespace: credentialsRef.Namespace, Name: credentialsRef.Name}
+	case ptr.Deref(shoot.Spec.SecretBindingName, "") != "":
+		secretBinding := &gardencorev1beta1.SecretBinding{}
+		secretBindingKey := client.ObjectKey{Namespace: shoot.Namespace, Name: *shoot.Spec.SecretBindingName}
+		if err := s.apiReader.Get(ctx, secretBindingKey, secretBinding); err != nil {
+			return nil, fmt.Errorf("failed to get secret binding %s: %w", secretBindingKey, err)
+		}
+		secretKey = client.ObjectKey{Namespace: secretBinding.SecretRef.Namespace, Name: secretBinding.SecretRef.Name}
+	default:
+		return nil, nil
+	}
+
+	// Explicitly use the client.Reader to prevent controller-runtime to start Informer for Secrets under the hood.
+	secret := &corev1.Secret{}
+	if err := s.apiReader.Get(ctx, secretKey, secret); err != nil {
+		return nil, fmt.Errorf("failed to get credentials secret %s: %w", secretKey, err)
+	}
+	return secret, nil
+}
@@ -76,7 +76,7 @@ var _ = Describe("SecretBinding validator", func() {
 				},
 			}
 			err := newValidator(secret).Validate(ctx, secretBinding, nil)
-			Expect(err).To(MatchError("missing field: token in cloud provider secret"))
+			Expect(err).To(MatchError(ContainSubstring("missing credentials in cloud provider secret")))
 		})
 
 		It("should return nil when the corresponding Secret is valid", func() {
@@ -25,14 +25,20 @@ import (
 
 type shoot struct {
 	client         client.Client
+	apiReader      client.Reader
 	decoder        runtime.Decoder
 	lenientDecoder runtime.Decoder
+	reviewer       *credentialsReviewer
 }
 
 // NewShootValidator returns a new instance of a shoot validator.
 func NewShootValidator(mgr manager.Manager) extensionswebhook.Validator {
 	return &shoot{
-		decoder: serializer.NewCodecFactory(mgr.GetScheme(), serializer.EnableStrict).UniversalDecoder(),
+		client:         mgr.GetClient(),
+		apiReader:      mgr.GetAPIReader(),
+		decoder:        serializer.NewCodecFactory(mgr.GetScheme(), serializer.EnableStrict).UniversalDecoder(),
+		lenientDecoder: serializer.NewCodecFactory(mgr.GetScheme()).UniversalDecoder(),
+		reviewer:       newCredentialsReviewer(DefaultCredentialsReviewOptions),
 	}
 }
 
@@ -69,10 +75,13 @@ type validationContext struct {
 	shoot                *core.Shoot
 	infrastructureConfig *apisironcore.InfrastructureConfig
 	controlPlaneConfig   *apisironcore.ControlPlaneConfig
-	cloudProfileSpec     *gardencorev1beta1.CloudProfileSpec
+	// workerConfigs contains the WorkerConfig of each worker of the shoot, or nil if the worker has none.
+	workerConfigs      []*apisironcore.WorkerConfig
+	cloudProfileSpec   *gardencorev1beta1.CloudProfileSpec
+	cloudProfileConfig *apisironcore.CloudProfileConfig
 }
 
-func (s *shoot) validateContext(valContext *validationContext) field.ErrorList {
+func (s *shoot) validateContext(valContext *validationContext, oldWorkers []core.Worker) field.ErrorList {
 	var (
 		allErrors = field.ErrorList{}
 	)
@@ -80,8 +89,16 @@ func (s *shoot) validateContext(valContext *validationContext) field.ErrorList {
 	allErrors = append(allErrors, ironcorevalidation.ValidateNetworking(valContext.shoot.Spec.Networking, networkPath)...)
 	allErrors = append(allErrors, ironcorevalidation.ValidateInfrastructureConfig(valContext.infrastructureConfig, valContext.shoot.Spec.Networking.Nodes, valContext.shoot.Spec.Networking.Pods, valContext.shoot.Spec.Networking.Services, infrastructureConfigPath)...)
 	allErrors = append(allErrors, ironcorevalidation.ValidateWorkers(valContext.shoot.Spec.Provider.Workers, workersPath)...)
+	allErrors = append(allErrors, ironcorevalidation.ValidateWorkersAgainstCloudProfile(oldWorkers, valContext.shoot.Spec.Provider.Workers, valContext.shoot.Spec.Region, valContext.cloudProfileConfig, workersPath)...)
 	allErrors = append(allErrors, ironcorevalidation.ValidateControlPlaneConfig(valContext.controlPlaneConfig, valContext.shoot.Spec.Kubernetes.Version, controlPlaneConfigPath)...)
 
+	for i, workerConfig := range valContext.workerConfigs {
+		if workerConfig != nil {
+			worker := valContext.shoot.Spec.Provider.Workers[i]
+			allErrors = append(allErrors, ironcorevalidation.ValidateWorkerConfig(workerConfig, worker, workersPath.Index(i).Child("providerConfig"))...)
+		}
+	}
+
 	return allErrors
 }
 
@@ -91,7 +108,21 @@ func (s *shoot) validateCreate(ctx context.Context, shoot *core.Shoot) error {
 		return err
 	}
 
-	return s.validateContext(validationContext).ToAggregate()
+	allErrors := s.validateContext(validationContext, nil)
+
+	networkInterfaceErrors, err := s.validateNetworkInterfaces(ctx, validationContext, nil)
+	if err != nil {
+		return err
+	}
+	allErrors = append(allErrors, networkInterfaceErrors...)
+
+	credentialsErrors, err := s.reviewCredentials(ctx, validationContext, nil)
+	if err != nil {
+		return err
+	}
+	allErrors = append(allErrors, credentialsErrors...)
+
+	return allErrors.ToAggregate()
 }
 
 func (s *shoot) validateUpdate(ctx context.Context, oldShoot, currentShoot *core.Shoot) error {
@@ -119,11 +150,23 @@ func (s *shoot) validateUpdate(ctx context.Context, oldShoot, currentShoot *core
 		allErrors = append(allErrors, ironcorevalidation.ValidateControlPlaneConfigUpdate(oldControlPlaneConfig, currentControlPlaneConfig, controlPlaneConfigPath)...)
 	}
 
+	allErrors = append(allErrors, ironcorevalidation.ValidateNetworkingUpdate(oldValContext.shoot.Spec.Networking, currentValContext.shoot.Spec.Networking, networkPath)...)
 	allErrors = append(allErrors, ironcorevalidation.ValidateWorkersUpdate(oldValContext.shoot.Spec.Provider.Workers, currentValContext.shoot.Spec.Provider.Workers, workersPath)...)
-	allErrors = append(allErrors, s.validateContext(currentValContext)...)
+	allErrors = append(allErrors, s.validateContext(currentValContext, oldValContext.shoot.Spec.Provider.Workers)...)
 
-	return allErrors.ToAggregate()
+	networkInterfaceErrors, err := s.validateNetworkInterfaces(ctx, currentValContext, oldValContext)
+	if err != nil {
+		return err
+	}
+	allErrors = append(allErrors, networkInterfaceErrors...)
+
+	credentialsErrors, err := s.reviewCredentials(ctx, currentValContext, oldValContext)
+	if err != nil {
+		return err
+	}
+	allErrors = append(allErrors, credentialsErrors...)
 
+	return allErrors.ToAggregate()
 }
 
 func newValidationContext(ctx context.Context, decoder runtime.Decoder, c client.Client, shoot *core.Shoot) (*validationContext, error) {
@@ -143,6 +186,18 @@ func newValidationContext(ctx context.Context, decoder runtime.Decoder, c client
 		return nil, fmt.Errorf("error decoding controlPlaneConfig: %v", err)
 	}
 
+	workerConfigs := make([]*apisironcore.WorkerConfig, len(shoot.Spec.Provider.Workers))
+	for i, worker := range shoot.Spec.Provider.Workers {
+		if worker.ProviderConfig == nil {
+			continue
+		}
+		workerConfig, err := admission.DecodeWorkerConfig(decoder, worker.ProviderConfig)
+		if err != nil {
+			return nil, fmt.Errorf("error decoding providerConfig of worker %q: %v", worker.Name, err)
+		}
+		workerConfigs[i] = workerConfig
+	}
+
 	shootV1Beta1 := &gardencorev1beta1.Shoot{}
 	err = gardencorev1beta1.Convert_core_Shoot_To_v1beta1_Shoot(shoot, shootV1Beta1, nil)
 	if err != nil {
@@ -159,11 +214,17 @@ func newValidationContext(ctx context.Context, decoder runtime.Decoder, c client
 	if cloudProfile.Spec.ProviderConfig == nil {
 		return nil, fmt.Errorf("providerConfig is not given for cloud profile %q", cloudProfile.Name)
 	}
+	cloudProfileConfig, err := decodeCloudProfileConfig(decoder, cloudProfile.Spec.ProviderConfig)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding providerConfig of cloud profile %q: %v", cloudProfile.Name, err)
+	}
 
 	return &validationContext{
 		shoot:                shoot,
 		infrastructureConfig: infrastructureConfig,
 		controlPlaneConfig:   controlPlaneConfig,
+		workerConfigs:        workerConfigs,
 		cloudProfileSpec:     &cloudProfile.Spec,
+		cloudProfileConfig:   cloudProfileConfig,
 	}, nil
 }
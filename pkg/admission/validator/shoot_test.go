@@ -0,0 +1,444 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package validator_test
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"strings"
+
+	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
+	"github.com/gardener/gardener/pkg/apis/core"
+	"github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	securityv1alpha1 "github.com/gardener/gardener/pkg/apis/security/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils/test"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/admission/validator"
+	"github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/apis/ironcore/install"
+	"github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/features"
+)
+
+var _ = Describe("Shoot Validator", func() {
+	var (
+		ctx = context.Background()
+
+		fakeClient     client.Client
+		shootValidator extensionswebhook.Validator
+		cloudProfile   *v1beta1.CloudProfile
+		shoot          *core.Shoot
+	)
+
+	cloudProfileConfig := []byte(`{
+"apiVersion":"ironcore.provider.extensions.gardener.cloud/v1alpha1",
+"kind":"CloudProfileConfig",
+"machineImages":[{"name":"gardenlinux","versions":[{"version":"1.0.0","image":"registry/image:1.0.0","architecture":"amd64"}]}],
+"storageClasses":{"default":{"name":"default","type":"general-purpose"},"additional":[{"name":"fast","type":"io-optimized"}]}
+}`)
+
+	errorWithField := func(errorType field.ErrorType, fld string) OmegaMatcher {
+		return ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(errorType),
+			"Field": Equal(fld),
+		})))
+	}
+
+	// setUpIroncoreRegion configures the region foo of the CloudProfile with the given server as failover server of an
+	// unreachable one and lets the shoot use credentials in the ironcore namespace ironcore-ns.
+	setUpIroncoreRegion := func(server *fakeAPIServer) {
+		caData, err := json.Marshal(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+		Expect(err).NotTo(HaveOccurred())
+		cloudProfile.Spec.ProviderConfig = &runtime.RawExtension{Raw: fmt.Appendf(nil, `{
+"apiVersion":"ironcore.provider.extensions.gardener.cloud/v1alpha1",
+"kind":"CloudProfileConfig",
+"machineImages":[{"name":"gardenlinux","versions":[{"version":"1.0.0","image":"registry/image:1.0.0","architecture":"amd64"}]}],
+"storageClasses":{"default":{"name":"default","type":"general-purpose"}},
+"regionConfigs":[{"name":"foo","server":"https://127.0.0.1:1","failoverServers":[%q],"certificateAuthorityData":%s}]
+}`, server.URL, caData)}
+
+		scheme := runtime.NewScheme()
+		utilruntime.Must(install.AddToScheme(scheme))
+		utilruntime.Must(v1beta1.AddToScheme(scheme))
+		utilruntime.Must(securityv1alpha1.AddToScheme(scheme))
+		utilruntime.Must(corev1.AddToScheme(scheme))
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(scheme).WithObjects(
+			cloudProfile,
+			&securityv1alpha1.CredentialsBinding{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-credentials", Namespace: "garden-dev"},
+				CredentialsRef: corev1.ObjectReference{
+					APIVersion: "v1",
+					Kind:       "Secret",
+					Namespace:  "garden-dev",
+					Name:       "my-provider-account",
+				},
+				Provider: securityv1alpha1.CredentialsBindingProvider{Type: "ironcore"},
+			},
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-provider-account", Namespace: "garden-dev"},
+				Data: map[string][]byte{
+					"namespace": []byte("ironcore-ns"),
+					"token":     []byte("token"),
+				},
+			},
+		).Build()
+		shootValidator = validator.NewShootValidator(&test.FakeManager{Client: fakeClient, APIReader: fakeClient, Scheme: scheme})
+
+		shoot.Spec.Region = "foo"
+		shoot.Spec.CredentialsBindingName = ptr.To("my-credentials")
+	}
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		utilruntime.Must(install.AddToScheme(scheme))
+		utilruntime.Must(v1beta1.AddToScheme(scheme))
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(scheme).Build()
+		shootValidator = validator.NewShootValidator(&test.FakeManager{Client: fakeClient, Scheme: scheme})
+
+		cloudProfile = &v1beta1.CloudProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: "cloud-profile"},
+			Spec: v1beta1.CloudProfileSpec{
+				ProviderConfig: &runtime.RawExtension{Raw: cloudProfileConfig},
+			},
+		}
+		shoot = &core.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-dev"},
+			Spec: core.ShootSpec{
+				CloudProfile: &core.CloudProfileReference{Kind: "CloudProfile", Name: "cloud-profile"},
+				Kubernetes:   core.Kubernetes{Version: "1.31.0"},
+				Networking:   &core.Networking{Nodes: ptr.To("10.0.0.0/16")},
+				Provider: core.Provider{
+					Type: "ironcore",
+					InfrastructureConfig: &runtime.RawExtension{Raw: []byte(`{
+"apiVersion":"ironcore.provider.extensions.gardener.cloud/v1alpha1",
+"kind":"InfrastructureConfig"
+}`)},
+					ControlPlaneConfig: &runtime.RawExtension{Raw: []byte(`{
+"apiVersion":"ironcore.provider.extensions.gardener.cloud/v1alpha1",
+"kind":"ControlPlaneConfig"
+}`)},
+					Workers: []core.Worker{
+						{
+							Name: "worker",
+							Machine: core.Machine{
+								Type:         "x3-xlarge",
+								Image:        &core.ShootMachineImage{Name: "gardenlinux", Version: "1.0.0"},
+								Architecture: ptr.To("amd64"),
+							},
+							Volume: &core.Volume{Type: ptr.To("general-purpose"), VolumeSize: "20Gi"},
+							Zones:  []string{"zone-a"},
+						},
+					},
+				},
+			},
+		}
+	})
+
+	Describe("#Validate", func() {
+		It("should return an error for a wrong object type", func() {
+			Expect(shootValidator.Validate(ctx, &corev1.Secret{}, nil)).To(MatchError("wrong object type *v1.Secret"))
+		})
+
+		Context("create", func() {
+			It("should succeed for a valid shoot", func() {
+				Expect(fakeClient.Create(ctx, cloudProfile)).To(Succeed())
+				Expect(shootValidator.Validate(ctx, shoot, nil)).To(Succeed())
+			})
+
+			It("should succeed for a shoot referencing a NamespacedCloudProfile", func() {
+				namespacedCloudProfile := &v1beta1.NamespacedCloudProfile{
+					ObjectMeta: metav1.ObjectMeta{Name: "namespaced-profile", Namespace: "garden-dev"},
+					Spec: v1beta1.NamespacedCloudProfileSpec{
+						Parent: v1beta1.CloudProfileReference{Kind: "CloudProfile", Name: "cloud-profile"},
+					},
+					Status: v1beta1.NamespacedCloudProfileStatus{
+						CloudProfileSpec: cloudProfile.Spec,
+					},
+				}
+				Expect(fakeClient.Create(ctx, namespacedCloudProfile)).To(Succeed())
+				shoot.Spec.CloudProfile = &core.CloudProfileReference{Kind: "NamespacedCloudProfile", Name: "namespaced-profile"}
+
+				Expect(shootValidator.Validate(ctx, shoot, nil)).To(Succeed())
+			})
+
+			It("should fail if the cloud profile does not exist", func() {
+				Expect(shootValidator.Validate(ctx, shoot, nil)).To(MatchError(ContainSubstring("not found")))
+			})
+
+			It("should fail if the infrastructureConfig is missing", func() {
+				shoot.Spec.Provider.InfrastructureConfig = nil
+				Expect(shootValidator.Validate(ctx, shoot, nil)).To(MatchError(ContainSubstring("infrastructureConfig must be set for ironcore shoots")))
+			})
+
+			It("should fail for workers which can't be mapped with the cloud profile", func() {
+				Expect(fakeClient.Create(ctx, cloudProfile)).To(Succeed())
+				shoot.Spec.Provider.Workers[0].Machine.Image.Version = "2.0.0"
+				shoot.Spec.Provider.Workers[0].Volume.Type = ptr.To("standard")
+
+				err := shootValidator.Validate(ctx, shoot, nil)
+				Expect(err).To(errorWithField(field.ErrorTypeInvalid, "spec.provider.workers[0].machine.image.version"))
+				Expect(err).To(errorWithField(field.ErrorTypeNotSupported, "spec.provider.workers[0].volume.type"))
+			})
+
+			It("should validate the priorities of the worker config", func() {
+				Expect(fakeClient.Create(ctx, cloudProfile)).To(Succeed())
+				shoot.Spec.Provider.Workers[0].ProviderConfig = &runtime.RawExtension{Raw: []byte(`{
+"apiVersion":"ironcore.provider.extensions.gardener.cloud/v1alpha1",
+"kind":"WorkerConfig",
+"priority":-2,
+"zonePriorities":[{"zone":"zone-b","priority":10}]
+}`)}
+
+				err := shootValidator.Validate(ctx, shoot, nil)
+				Expect(err).To(errorWithField(field.ErrorTypeInvalid, "spec.provider.workers[0].providerConfig.priority"))
+				Expect(err).To(errorWithField(field.ErrorTypeNotSupported, "spec.provider.workers[0].providerConfig.zonePriorities[0].zone"))
+			})
+
+			It("should fail if the worker config can't be decoded", func() {
+				Expect(fakeClient.Create(ctx, cloudProfile)).To(Succeed())
+				shoot.Spec.Provider.Workers[0].ProviderConfig = &runtime.RawExtension{Raw: []byte(`{
+"apiVersion":"ironcore.provider.extensions.gardener.cloud/v1alpha1",
+"kind":"WorkerConfig",
+"foo":"bar"
+}`)}
+
+				Expect(shootValidator.Validate(ctx, shoot, nil)).To(MatchError(ContainSubstring(`error decoding providerConfig of worker "worker"`)))
+			})
+		})
+
+		Context("update", func() {
+			var oldShoot *core.Shoot
+
+			BeforeEach(func() {
+				Expect(fakeClient.Create(ctx, cloudProfile)).To(Succeed())
+				oldShoot = shoot.DeepCopy()
+			})
+
+			It("should succeed for an unchanged shoot", func() {
+				Expect(shootValidator.Validate(ctx, shoot, oldShoot)).To(Succeed())
+			})
+
+			It("should decode the old shoot leniently", func() {
+				oldShoot.Spec.Provider.ControlPlaneConfig = &runtime.RawExtension{Raw: []byte(`{
+"apiVersion":"ironcore.provider.extensions.gardener.cloud/v1alpha1",
+"kind":"ControlPlaneConfig",
+"removedField":true
+}`)}
+				Expect(shootValidator.Validate(ctx, shoot, oldShoot)).To(Succeed())
+			})
+
+			It("should forbid changing the nodes CIDR", func() {
+				shoot.Spec.Networking.Nodes = ptr.To("10.1.0.0/16")
+				Expect(shootValidator.Validate(ctx, shoot, oldShoot)).To(errorWithField(field.ErrorTypeInvalid, "spec.networking.nodes"))
+			})
+
+			It("should forbid adding a network reference", func() {
+				shoot.Spec.Provider.InfrastructureConfig = &runtime.RawExtension{Raw: []byte(`{
+"apiVersion":"ironcore.provider.extensions.gardener.cloud/v1alpha1",
+"kind":"InfrastructureConfig",
+"networkRef":{"name":"my-network"}
+}`)}
+				Expect(shootValidator.Validate(ctx, shoot, oldShoot)).To(errorWithField(field.ErrorTypeForbidden, "spec.provider.infrastructureConfig.networkRef"))
+			})
+
+			It("should forbid changing the volume type of worker pools which are updated in-place", func() {
+				shoot.Spec.Provider.Workers[0].UpdateStrategy = ptr.To(core.AutoInPlaceUpdate)
+				oldShoot.Spec.Provider.Workers[0].UpdateStrategy = ptr.To(core.AutoInPlaceUpdate)
+				shoot.Spec.Provider.Workers[0].Volume.Type = ptr.To("io-optimized")
+				Expect(shootValidator.Validate(ctx, shoot, oldShoot)).To(errorWithField(field.ErrorTypeForbidden, "spec.provider.workers[0].volume.type"))
+			})
+
+			It("should allow changing the volume type of worker pools with a rolling update", func() {
+				shoot.Spec.Provider.Workers[0].Volume.Type = ptr.To("io-optimized")
+				Expect(shootValidator.Validate(ctx, shoot, oldShoot)).To(Succeed())
+			})
+
+			It("should not reject unchanged workers which can't be mapped anymore", func() {
+				shoot.Spec.Provider.Workers[0].Volume.Type = ptr.To("standard")
+				oldShoot.Spec.Provider.Workers[0].Volume.Type = ptr.To("standard")
+				Expect(shootValidator.Validate(ctx, shoot, oldShoot)).To(Succeed())
+			})
+		})
+	})
+
+	Describe("network interfaces", func() {
+		var server *fakeAPIServer
+
+		networkInterfacesConfig := func(networkNames ...string) *runtime.RawExtension {
+			var networkInterfaces []string
+			for i, networkName := range networkNames {
+				networkInterfaces = append(networkInterfaces, fmt.Sprintf(`{"name":"nic-%d","networkName":%q}`, i, networkName))
+			}
+			return &runtime.RawExtension{Raw: fmt.Appendf(nil, `{
+"apiVersion":"ironcore.provider.extensions.gardener.cloud/v1alpha1",
+"kind":"WorkerConfig",
+"networkInterfaces":[%s]
+}`, strings.Join(networkInterfaces, ","))}
+		}
+
+		BeforeEach(func() {
+			DeferCleanup(test.WithFeatureGate(features.ExtensionFeatureGate, features.NetworkInterfaces, true))
+
+			server = newFakeAPIServer("token")
+			DeferCleanup(server.Close)
+			server.addNetwork("ironcore-ns", "storage-network")
+			setUpIroncoreRegion(server)
+		})
+
+		It("should accept network interfaces in existing networks", func() {
+			shoot.Spec.Provider.Workers[0].ProviderConfig = networkInterfacesConfig("storage-network")
+			Expect(shootValidator.Validate(ctx, shoot, nil)).To(Succeed())
+			Expect(server.networkGetCount()).To(Equal(1))
+		})
+
+		It("should reject network interfaces in networks which do not exist", func() {
+			shoot.Spec.Provider.Workers[0].ProviderConfig = networkInterfacesConfig("storage-network", "backend-network")
+			Expect(shootValidator.Validate(ctx, shoot, nil)).To(SatisfyAll(
+				errorWithField(field.ErrorTypeNotFound, "spec.provider.workers[0].providerConfig.networkInterfaces[1].networkName"),
+				Not(errorWithField(field.ErrorTypeNotFound, "spec.provider.workers[0].providerConfig.networkInterfaces[0].networkName")),
+			))
+		})
+
+		It("should reject network interfaces in the network of the shoot", func() {
+			shoot.Spec.Provider.Workers[0].ProviderConfig = networkInterfacesConfig("shoot--garden-dev--shoot")
+			Expect(shootValidator.Validate(ctx, shoot, nil)).To(
+				errorWithField(field.ErrorTypeInvalid, "spec.provider.workers[0].providerConfig.networkInterfaces[0].networkName"))
+
+			shoot.Spec.Provider.InfrastructureConfig = &runtime.RawExtension{Raw: []byte(`{
+"apiVersion":"ironcore.provider.extensions.gardener.cloud/v1alpha1",
+"kind":"InfrastructureConfig",
+"networkRef":{"name":"storage-network"}
+}`)}
+			shoot.Spec.Provider.Workers[0].ProviderConfig = networkInterfacesConfig("storage-network")
+			Expect(shootValidator.Validate(ctx, shoot, nil)).To(
+				errorWithField(field.ErrorTypeInvalid, "spec.provider.workers[0].providerConfig.networkInterfaces[0].networkName"))
+		})
+
+		It("should only look up the networks of added network interfaces on update", func() {
+			shoot.Spec.Provider.Workers[0].ProviderConfig = networkInterfacesConfig("removed-network")
+			oldShoot := shoot.DeepCopy()
+			Expect(shootValidator.Validate(ctx, shoot, oldShoot)).To(Succeed())
+			Expect(server.networkGetCount()).To(Equal(0))
+
+			shoot.Spec.Provider.Workers[0].ProviderConfig = networkInterfacesConfig("removed-network", "backend-network")
+			Expect(shootValidator.Validate(ctx, shoot, oldShoot)).To(
+				errorWithField(field.ErrorTypeNotFound, "spec.provider.workers[0].providerConfig.networkInterfaces[1].networkName"))
+			Expect(server.networkGetCount()).To(Equal(1))
+		})
+
+		It("should not look up networks of a shoot in deletion", func() {
+			shoot.Spec.Provider.Workers[0].ProviderConfig = networkInterfacesConfig("backend-network")
+			shoot.DeletionTimestamp = ptr.To(metav1.Now())
+			Expect(shootValidator.Validate(ctx, shoot, nil)).To(Succeed())
+			Expect(server.networkGetCount()).To(Equal(0))
+		})
+
+		It("should only reject added or changed network interfaces if the feature gate is disabled", func() {
+			DeferCleanup(test.WithFeatureGate(features.ExtensionFeatureGate, features.NetworkInterfaces, false))
+
+			shoot.Spec.Provider.Workers[0].ProviderConfig = networkInterfacesConfig("storage-network")
+			Expect(shootValidator.Validate(ctx, shoot, nil)).To(
+				errorWithField(field.ErrorTypeForbidden, "spec.provider.workers[0].providerConfig.networkInterfaces"))
+
+			oldShoot := shoot.DeepCopy()
+			Expect(shootValidator.Validate(ctx, shoot, oldShoot)).To(Succeed())
+
+			shoot.Spec.Provider.Workers[0].ProviderConfig = networkInterfacesConfig("storage-network", "backend-network")
+			Expect(shootValidator.Validate(ctx, shoot, oldShoot)).To(
+				errorWithField(field.ErrorTypeForbidden, "spec.provider.workers[0].providerConfig.networkInterfaces"))
+			Expect(server.networkGetCount()).To(Equal(0))
+		})
+
+		It("should not look up networks without a region config for the region of the shoot", func() {
+			shoot.Spec.Region = "bar"
+			shoot.Spec.Provider.Workers[0].ProviderConfig = networkInterfacesConfig("backend-network")
+			Expect(shootValidator.Validate(ctx, shoot, nil)).To(Succeed())
+			Expect(server.networkGetCount()).To(Equal(0))
+		})
+	})
+
+	Describe("credentials review", func() {
+		var server *fakeAPIServer
+
+		BeforeEach(func() {
+			oldOptions := validator.DefaultCredentialsReviewOptions
+			validator.DefaultCredentialsReviewOptions = validator.CredentialsReviewOptions{Enabled: true}
+			DeferCleanup(func() {
+				validator.DefaultCredentialsReviewOptions = oldOptions
+			})
+
+			server = newFakeAPIServer("token")
+			DeferCleanup(server.Close)
+			setUpIroncoreRegion(server)
+		})
+
+		It("should accept credentials which have all required permissions on a failover server", func() {
+			Expect(shootValidator.Validate(ctx, shoot, nil)).To(Succeed())
+			Expect(server.reviewCount()).To(Equal(30))
+			Expect(server.lastReviewedNamespace()).To(Equal("ironcore-ns"))
+		})
+
+		It("should cache successful reviews", func() {
+			Expect(shootValidator.Validate(ctx, shoot, nil)).To(Succeed())
+			Expect(shootValidator.Validate(ctx, shoot, nil)).To(Succeed())
+			Expect(server.reviewCount()).To(Equal(30))
+		})
+
+		It("should reject credentials which are missing permissions until they are granted", func() {
+			server.deny("delete buckets.storage.ironcore.dev")
+			server.deny("create machines.compute.ironcore.dev")
+
+			err := shootValidator.Validate(ctx, shoot, nil)
+			Expect(err).To(errorWithField(field.ErrorTypeForbidden, "spec.credentialsBindingName"))
+			Expect(err).To(MatchError(ContainSubstring("credentials are missing permissions in namespace ironcore-ns of region foo: create machines.compute.ironcore.dev, delete buckets.storage.ironcore.dev")))
+
+			server.allow("delete buckets.storage.ironcore.dev")
+			server.allow("create machines.compute.ironcore.dev")
+			Expect(shootValidator.Validate(ctx, shoot, nil)).To(Succeed())
+			Expect(server.reviewCount()).To(Equal(60))
+		})
+
+		It("should reject credentials which are not accepted by the API server", func() {
+			server.token = "other-token"
+			Expect(shootValidator.Validate(ctx, shoot, nil)).To(SatisfyAll(
+				errorWithField(field.ErrorTypeForbidden, "spec.credentialsBindingName"),
+				MatchError(ContainSubstring("credentials are not accepted by the ironcore API server of region foo")),
+			))
+		})
+
+		It("should only review the credentials of an existing shoot if its region or credentials change", func() {
+			server.deny("list prefixes.ipam.ironcore.dev")
+			oldShoot := shoot.DeepCopy()
+			Expect(shootValidator.Validate(ctx, shoot, oldShoot)).To(Succeed())
+			Expect(server.reviewCount()).To(BeZero())
+
+			oldShoot.Spec.CredentialsBindingName = ptr.To("old-credentials")
+			Expect(shootValidator.Validate(ctx, shoot, oldShoot)).To(MatchError(ContainSubstring("list prefixes.ipam.ironcore.dev")))
+		})
+
+		It("should not review credentials without a region config for the region of the shoot", func() {
+			shoot.Spec.Region = "bar"
+			Expect(shootValidator.Validate(ctx, shoot, nil)).To(Succeed())
+			Expect(server.reviewCount()).To(BeZero())
+		})
+
+		It("should not review credentials if the review is disabled", func() {
+			validator.DefaultCredentialsReviewOptions = validator.CredentialsReviewOptions{}
+			setUpIroncoreRegion(server)
+			Expect(shootValidator.Validate(ctx, shoot, nil)).To(Succeed())
+			Expect(server.reviewCount()).To(BeZero())
+		})
+	})
+})
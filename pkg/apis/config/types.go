@@ -31,6 +31,8 @@ type ControllerConfiguration struct {
 	BastionConfig *BastionConfig
 	// BackupBucketConfig is config for Backup Bucket
 	BackupBucketConfig *BackupBucketConfig
+	// IroncoreClientConfig is the config for the clients of the ironcore API.
+	IroncoreClientConfig *IroncoreClientConfig
 }
 
 // ETCD is an etcd configuration.
@@ -69,4 +71,14 @@ type BastionConfig struct {
 type BackupBucketConfig struct {
 	// BucketClassName is the name of the ironcore BucketClass to use for the BackupBucket
 	BucketClassName string
+	// ReplicationInterval is the interval in which backups are replicated to secondary buckets.
+	ReplicationInterval *metav1.Duration
+}
+
+// IroncoreClientConfig is the config for the clients of the ironcore API.
+type IroncoreClientConfig struct {
+	// CacheTTL is the duration after which a cached ironcore client is recreated.
+	CacheTTL *metav1.Duration
+	// InformerCache enables informer-backed reads for the ironcore namespace of a shoot.
+	InformerCache bool
 }
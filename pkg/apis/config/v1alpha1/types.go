@@ -36,6 +36,9 @@ type ControllerConfiguration struct {
 	BastionConfig *BastionConfig `json:"bastionConfig,omitempty"`
 	// BackupBucketConfig is config for Backup Bucket
 	BackupBucketConfig *BackupBucketConfig `json:"backupBucketConfig,omitempty"`
+	// IroncoreClientConfig is the config for the clients of the ironcore API.
+	// +optional
+	IroncoreClientConfig *IroncoreClientConfig `json:"ironcoreClientConfig,omitempty"`
 }
 
 // ETCD is an etcd configuration.
@@ -77,4 +80,18 @@ type BastionConfig struct {
 type BackupBucketConfig struct {
 	// BucketClassName is the name of the ironcore BucketClass to use for the BackupBucket
 	BucketClassName string `json:"bucketClassName,omitempty"`
+	// ReplicationInterval is the interval in which backups are replicated to secondary buckets.
+	// +optional
+	ReplicationInterval *metav1.Duration `json:"replicationInterval,omitempty"`
+}
+
+// IroncoreClientConfig is the config for the clients of the ironcore API.
+type IroncoreClientConfig struct {
+	// CacheTTL is the duration after which a cached ironcore client is recreated. Recreating a client re-evaluates
+	// which server of the region is reachable. Defaults to 10m.
+	// +optional
+	CacheTTL *metav1.Duration `json:"cacheTTL,omitempty"`
+	// InformerCache enables informer-backed reads for the ironcore namespace of a shoot.
+	// +optional
+	InformerCache bool `json:"informerCache,omitempty"`
 }
@@ -15,6 +15,7 @@ import (
 	apisconfigv1alpha1 "github.com/gardener/gardener/extensions/pkg/apis/config/v1alpha1"
 	config "github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/apis/config"
 	resource "k8s.io/apimachinery/pkg/api/resource"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	configv1alpha1 "k8s.io/component-base/config/v1alpha1"
@@ -87,11 +88,22 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*IroncoreClientConfig)(nil), (*config.IroncoreClientConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_IroncoreClientConfig_To_config_IroncoreClientConfig(a.(*IroncoreClientConfig), b.(*config.IroncoreClientConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.IroncoreClientConfig)(nil), (*IroncoreClientConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_IroncoreClientConfig_To_v1alpha1_IroncoreClientConfig(a.(*config.IroncoreClientConfig), b.(*IroncoreClientConfig), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
 func autoConvert_v1alpha1_BackupBucketConfig_To_config_BackupBucketConfig(in *BackupBucketConfig, out *config.BackupBucketConfig, s conversion.Scope) error {
 	out.BucketClassName = in.BucketClassName
+	out.ReplicationInterval = (*v1.Duration)(unsafe.Pointer(in.ReplicationInterval))
 	return nil
 }
 
@@ -102,6 +114,7 @@ func Convert_v1alpha1_BackupBucketConfig_To_config_BackupBucketConfig(in *Backup
 
 func autoConvert_config_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(in *config.BackupBucketConfig, out *BackupBucketConfig, s conversion.Scope) error {
 	out.BucketClassName = in.BucketClassName
+	out.ReplicationInterval = (*v1.Duration)(unsafe.Pointer(in.ReplicationInterval))
 	return nil
 }
 
@@ -143,6 +156,7 @@ func autoConvert_v1alpha1_ControllerConfiguration_To_config_ControllerConfigurat
 	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
 	out.BastionConfig = (*config.BastionConfig)(unsafe.Pointer(in.BastionConfig))
 	out.BackupBucketConfig = (*config.BackupBucketConfig)(unsafe.Pointer(in.BackupBucketConfig))
+	out.IroncoreClientConfig = (*config.IroncoreClientConfig)(unsafe.Pointer(in.IroncoreClientConfig))
 	return nil
 }
 
@@ -160,6 +174,7 @@ func autoConvert_config_ControllerConfiguration_To_v1alpha1_ControllerConfigurat
 	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
 	out.BastionConfig = (*BastionConfig)(unsafe.Pointer(in.BastionConfig))
 	out.BackupBucketConfig = (*BackupBucketConfig)(unsafe.Pointer(in.BackupBucketConfig))
+	out.IroncoreClientConfig = (*IroncoreClientConfig)(unsafe.Pointer(in.IroncoreClientConfig))
 	return nil
 }
 
@@ -239,3 +254,25 @@ func autoConvert_config_ETCDStorage_To_v1alpha1_ETCDStorage(in *config.ETCDStora
 func Convert_config_ETCDStorage_To_v1alpha1_ETCDStorage(in *config.ETCDStorage, out *ETCDStorage, s conversion.Scope) error {
 	return autoConvert_config_ETCDStorage_To_v1alpha1_ETCDStorage(in, out, s)
 }
+
+func autoConvert_v1alpha1_IroncoreClientConfig_To_config_IroncoreClientConfig(in *IroncoreClientConfig, out *config.IroncoreClientConfig, s conversion.Scope) error {
+	out.CacheTTL = (*v1.Duration)(unsafe.Pointer(in.CacheTTL))
+	out.InformerCache = in.InformerCache
+	return nil
+}
+
+// Convert_v1alpha1_IroncoreClientConfig_To_config_IroncoreClientConfig is an autogenerated conversion function.
+func Convert_v1alpha1_IroncoreClientConfig_To_config_IroncoreClientConfig(in *IroncoreClientConfig, out *config.IroncoreClientConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_IroncoreClientConfig_To_config_IroncoreClientConfig(in, out, s)
+}
+
+func autoConvert_config_IroncoreClientConfig_To_v1alpha1_IroncoreClientConfig(in *config.IroncoreClientConfig, out *IroncoreClientConfig, s conversion.Scope) error {
+	out.CacheTTL = (*v1.Duration)(unsafe.Pointer(in.CacheTTL))
+	out.InformerCache = in.InformerCache
+	return nil
+}
+
+// Convert_config_IroncoreClientConfig_To_v1alpha1_IroncoreClientConfig is an autogenerated conversion function.
+func Convert_config_IroncoreClientConfig_To_v1alpha1_IroncoreClientConfig(in *config.IroncoreClientConfig, out *IroncoreClientConfig, s conversion.Scope) error {
+	return autoConvert_config_IroncoreClientConfig_To_v1alpha1_IroncoreClientConfig(in, out, s)
+}
@@ -11,6 +11,7 @@ package v1alpha1
 
 import (
 	apisconfigv1alpha1 "github.com/gardener/gardener/extensions/pkg/apis/config/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	configv1alpha1 "k8s.io/component-base/config/v1alpha1"
 )
@@ -18,6 +19,11 @@ import (
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackupBucketConfig) DeepCopyInto(out *BackupBucketConfig) {
 	*out = *in
+	if in.ReplicationInterval != nil {
+		in, out := &in.ReplicationInterval, &out.ReplicationInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -77,7 +83,12 @@ func (in *ControllerConfiguration) DeepCopyInto(out *ControllerConfiguration) {
 	if in.BackupBucketConfig != nil {
 		in, out := &in.BackupBucketConfig, &out.BackupBucketConfig
 		*out = new(BackupBucketConfig)
-		**out = **in
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IroncoreClientConfig != nil {
+		in, out := &in.IroncoreClientConfig, &out.IroncoreClientConfig
+		*out = new(IroncoreClientConfig)
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
@@ -164,3 +175,24 @@ func (in *ETCDStorage) DeepCopy() *ETCDStorage {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IroncoreClientConfig) DeepCopyInto(out *IroncoreClientConfig) {
+	*out = *in
+	if in.CacheTTL != nil {
+		in, out := &in.CacheTTL, &out.CacheTTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IroncoreClientConfig.
+func (in *IroncoreClientConfig) DeepCopy() *IroncoreClientConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(IroncoreClientConfig)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -76,3 +76,51 @@ func CloudProfileConfigFromCluster(cluster *controller.Cluster) (*api.CloudProfi
 	}
 	return cloudProfileConfig, nil
 }
+
+// BackupBucketConfigFromBackupBucket extracts the BackupBucketConfig from the
+// ProviderConfig section of the given BackupBucket.
+func BackupBucketConfigFromBackupBucket(backupBucket *extensionsv1alpha1.BackupBucket) (*api.BackupBucketConfig, error) {
+	config := &api.BackupBucketConfig{}
+	if backupBucket.Spec.ProviderConfig != nil && backupBucket.Spec.ProviderConfig.Raw != nil {
+		if _, _, err := decoder.Decode(backupBucket.Spec.ProviderConfig.Raw, nil, config); err != nil {
+			return nil, err
+		}
+	}
+	return config, nil
+}
+
+// BackupBucketStatusFromRaw extracts the BackupBucketStatus from the
+// ProviderStatus section of the given BackupBucket.
+func BackupBucketStatusFromRaw(raw *runtime.RawExtension) (*api.BackupBucketStatus, error) {
+	status := &api.BackupBucketStatus{}
+	if raw != nil && raw.Raw != nil {
+		if _, _, err := lenientDecoder.Decode(raw.Raw, nil, status); err != nil {
+			return nil, err
+		}
+	}
+	return status, nil
+}
+
+// WorkerConfigFromRaw extracts the WorkerConfig from the
+// ProviderConfig section of a worker pool.
+func WorkerConfigFromRaw(raw *runtime.RawExtension) (*api.WorkerConfig, error) {
+	config := &api.WorkerConfig{}
+	if raw != nil && raw.Raw != nil {
+		if _, _, err := decoder.Decode(raw.Raw, nil, config); err != nil {
+			return nil, err
+		}
+	}
+	return config, nil
+}
+
+// WorkerStatusFromRaw extracts the WorkerStatus from the
+// ProviderStatus section of the given Worker.
+func WorkerStatusFromRaw(raw *runtime.RawExtension) (*api.WorkerStatus, error) {
+	status := &api.WorkerStatus{}
+	if raw != nil && raw.Raw != nil {
+		if _, _, err := lenientDecoder.Decode(raw.Raw, nil, status); err != nil {
+			return nil, err
+		}
+	}
+	return status, nil
+}
@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package ironcore
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BackupBucketConfig contains provider-specific configuration for a BackupBucket.
+type BackupBucketConfig struct {
+	metav1.TypeMeta
+
+	// Replication configures an optional secondary bucket the backups are replicated to.
+	Replication *BackupBucketReplication
+}
+
+// BackupBucketReplication describes the secondary bucket backups are replicated to.
+type BackupBucketReplication struct {
+	// Region is the region of the secondary bucket.
+	Region string
+	// SecretRef references a secret containing the kubeconfig and namespace for the ironcore API of the secondary region.
+	SecretRef corev1.SecretReference
+	// BucketClassName is the name of the BucketClass used for the secondary bucket. Defaults to the bucket class
+	// configured for the primary bucket.
+	BucketClassName *string
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BackupBucketStatus contains information about the BackupBucket.
+type BackupBucketStatus struct {
+	metav1.TypeMeta
+
+	// Replication contains the state of the replication to the secondary bucket.
+	Replication *BackupBucketReplicationStatus
+}
+
+// BackupBucketReplicationStatus contains the state of the replication to the secondary bucket.
+type BackupBucketReplicationStatus struct {
+	// Region is the region of the secondary bucket.
+	Region string
+	// BucketName is the name of the secondary bucket.
+	BucketName string
+	// SecretRef references the secret for the ironcore API of the secondary region the bucket was created with.
+	SecretRef corev1.SecretReference
+	// LastReplicationTime is the time the last replication run finished.
+	LastReplicationTime *metav1.Time
+	// PendingObjects is the number of objects which have not been replicated yet.
+	PendingObjects int32
+	// Lag is the age of the oldest object which has not been replicated yet.
+	Lag *metav1.Duration
+}
@@ -4,6 +4,8 @@
 package ironcore
 
 import (
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -21,6 +23,8 @@ type CloudProfileConfig struct {
 	RegionConfigs []RegionConfig
 	// StorageClasses defines the DefaultStrorageClass and AdditionalStoreClasses for the shoot
 	StorageClasses StorageClasses
+	// VolumeSnapshotClasses defines the VolumeSnapshotClasses which are deployed to shoots that enable volume snapshots.
+	VolumeSnapshotClasses []VolumeSnapshotClass
 }
 
 // StorageClasses is a definition of a storageClasses
@@ -29,6 +33,9 @@ type StorageClasses struct {
 	Default *StorageClass
 	// Additional defines the additional storage classes for the shoot
 	Additional []StorageClass
+	// DiscoverVolumeClasses generates a StorageClass for every VolumeClass visible to the shoot credentials
+	// which is not referenced by the Default or Additional storage classes.
+	DiscoverVolumeClasses bool
 }
 
 // StorageClass is the definition of a storageClass
@@ -37,8 +44,42 @@ type StorageClass struct {
 	Name string
 	// Type is referring to the VolumeClass to use for this StorageClass
 	Type string
+	// ReclaimPolicy is the reclaim policy of the PersistentVolumes created by this StorageClass. Defaults to Delete.
+	ReclaimPolicy *corev1.PersistentVolumeReclaimPolicy
+	// VolumeBindingMode is the binding mode of this StorageClass. Defaults to WaitForFirstConsumer.
+	VolumeBindingMode *storagev1.VolumeBindingMode
+	// MountOptions are the mount options of the PersistentVolumes created by this StorageClass.
+	MountOptions []string
+	// Encrypted requests encrypted ironcore volumes for this StorageClass.
+	Encrypted *bool
+	// AllowedZones restricts the provisioning of volumes to the given zones.
+	AllowedZones []string
+	// Parameters are additional parameters passed to the CSI driver.
+	Parameters map[string]string
 }
 
+// VolumeSnapshotClass is the definition of a volumeSnapshotClass
+type VolumeSnapshotClass struct {
+	// Name is the name of the volumeSnapshotClass
+	Name string
+	// Default marks this volumeSnapshotClass as the default one for the shoot
+	Default *bool
+	// DeletionPolicy defines whether the ironcore snapshot is retained or deleted together with the VolumeSnapshotContent
+	DeletionPolicy *VolumeSnapshotDeletionPolicy
+	// Parameters are passed to the CSI driver when creating a snapshot
+	Parameters map[string]string
+}
+
+// VolumeSnapshotDeletionPolicy is the deletion policy of a VolumeSnapshotClass.
+type VolumeSnapshotDeletionPolicy string
+
+const (
+	// VolumeSnapshotDeletionPolicyDelete deletes the ironcore snapshot once the VolumeSnapshotContent is deleted.
+	VolumeSnapshotDeletionPolicyDelete VolumeSnapshotDeletionPolicy = "Delete"
+	// VolumeSnapshotDeletionPolicyRetain keeps the ironcore snapshot once the VolumeSnapshotContent is deleted.
+	VolumeSnapshotDeletionPolicyRetain VolumeSnapshotDeletionPolicy = "Retain"
+)
+
 // MachineImages is a mapping from logical names and versions to provider-specific identifiers.
 type MachineImages struct {
 	// Name is the logical name of the machine image.
@@ -55,6 +96,25 @@ type RegionConfig struct {
 	Server string
 	// CertificateAuthorityData is the base64-encoded CA data of the region server.
 	CertificateAuthorityData []byte
+	// FailoverServers is an ordered list of additional server endpoints of this region. They are tried in order
+	// if Server is not reachable and have to be served with the same certificate authority.
+	FailoverServers []string
+	// ProxyURL is the URL of the proxy used to connect to the servers of this region.
+	ProxyURL *string
+	// TLSServerName is the server name used to verify the serving certificate of the servers of this region.
+	TLSServerName *string
+	// Zones contains the ironcore specific configuration of the zones of this region.
+	Zones []ZoneConfig
+}
+
+// ZoneConfig is the definition of a zone of a region.
+type ZoneConfig struct {
+	// Name is the name of the zone.
+	Name string
+	// VolumePoolName is the name of the VolumePool in which volumes of this zone are created.
+	VolumePoolName *string
+	// MachinePoolSelector selects the MachinePools in which the machines of this zone are created.
+	MachinePoolSelector map[string]string
 }
 
 // MachineImageVersion contains a version and a provider-specific identifier.
@@ -4,6 +4,7 @@
 package ironcore
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -16,10 +17,90 @@ type ControlPlaneConfig struct {
 
 	// CloudControllerManager contains configuration settings for the cloud-controller-manager.
 	CloudControllerManager *CloudControllerManagerConfig
+	// Storage contains configuration for the storage in the cluster.
+	Storage *Storage
+}
+
+// Storage contains configuration for the storage in the cluster.
+type Storage struct {
+	// VolumeSnapshots contains configuration for volume snapshots of the CSI driver.
+	VolumeSnapshots *VolumeSnapshots
+	// StorageClasses overrides the StorageClasses of the CloudProfile with the same name and adds the other ones to the shoot.
+	StorageClasses []StorageClass
+}
+
+// VolumeSnapshots contains configuration for volume snapshots of the CSI driver.
+type VolumeSnapshots struct {
+	// Enabled deploys the csi-snapshotter, the snapshot-controller and the VolumeSnapshotClasses of the CloudProfile.
+	Enabled bool
 }
 
 // CloudControllerManagerConfig contains configuration settings for the cloud-controller-manager.
 type CloudControllerManagerConfig struct {
 	// FeatureGates contains information about enabled feature gates.
 	FeatureGates map[string]bool
+	// LoadBalancer contains configuration settings for the load balancers managed by the cloud-controller-manager.
+	LoadBalancer *LoadBalancerConfig
+	// RouteController contains configuration settings for the route controller of the cloud-controller-manager.
+	RouteController *RouteControllerConfig
 }
+
+// LoadBalancerType is the type of ironcore LoadBalancer.
+type LoadBalancerType string
+
+const (
+	// LoadBalancerTypePublic is a LoadBalancer with a public virtual IP.
+	LoadBalancerTypePublic LoadBalancerType = "Public"
+	// LoadBalancerTypeInternal is a LoadBalancer which is only reachable from within the network.
+	LoadBalancerTypeInternal LoadBalancerType = "Internal"
+)
+
+// SourceRangeEnforcementMode describes how the `loadBalancerSourceRanges` of a Service are handled.
+type SourceRangeEnforcementMode string
+
+const (
+	// SourceRangeEnforcementModeEnforce restricts the traffic to a LoadBalancer to the source ranges of the Service.
+	SourceRangeEnforcementModeEnforce SourceRangeEnforcementMode = "Enforce"
+	// SourceRangeEnforcementModeIgnore ignores the source ranges of the Service.
+	SourceRangeEnforcementModeIgnore SourceRangeEnforcementMode = "Ignore"
+)
+
+// LoadBalancerConfig contains configuration settings for load balancers.
+type LoadBalancerConfig struct {
+	// DefaultType is the type of LoadBalancer used for Services which do not request a specific type.
+	// Defaults to `Public`.
+	DefaultType *LoadBalancerType
+	// VirtualIP configures where the virtual IPs of LoadBalancers are allocated from.
+	VirtualIP *VirtualIPConfig
+	// SourceRangeEnforcement is the mode how `loadBalancerSourceRanges` of Services are handled.
+	// Defaults to `Enforce`.
+	SourceRangeEnforcement *SourceRangeEnforcementMode
+}
+
+// VirtualIPConfig configures where the virtual IPs of LoadBalancers are allocated from.
+// Only one of Prefix and PoolRef may be set.
+type VirtualIPConfig struct {
+	// Prefix is a CIDR the virtual IPs are allocated from.
+	Prefix *string
+	// PoolRef references an ironcore Prefix the virtual IPs are allocated from.
+	PoolRef *corev1.LocalObjectReference
+}
+
+// RouteControllerConfig contains configuration settings for the route controller.
+type RouteControllerConfig struct {
+	// ReconciliationPeriod is the period in which the routes of the nodes are reconciled.
+	ReconciliationPeriod *metav1.Duration
+	// PodPrefixMode is the way the pod CIDR of a node is attached to its ironcore NetworkInterface
+	// if the overlay of the shoot networking is disabled. Defaults to `Routed`.
+	PodPrefixMode *PodPrefixMode
+}
+
+// PodPrefixMode describes how the pod CIDR of a node is attached to its ironcore NetworkInterface.
+type PodPrefixMode string
+
+const (
+	// PodPrefixModeRouted adds the pod CIDR of a node as routed prefix to its NetworkInterface.
+	PodPrefixModeRouted PodPrefixMode = "Routed"
+	// PodPrefixModeAlias attaches the pod CIDR of a node as AliasPrefix to its NetworkInterface.
+	PodPrefixModeAlias PodPrefixMode = "Alias"
+)
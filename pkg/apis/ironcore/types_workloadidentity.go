@@ -0,0 +1,18 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package ironcore
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// WorkloadIdentityConfig contains the ironcore specific configuration of a WorkloadIdentity.
+type WorkloadIdentityConfig struct {
+	metav1.TypeMeta
+
+	// Namespace is the ironcore namespace the workload identity is granted access to.
+	Namespace string
+}
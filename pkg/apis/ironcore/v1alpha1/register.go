@@ -32,7 +32,11 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&InfrastructureConfig{},
 		&InfrastructureStatus{},
 		&ControlPlaneConfig{},
+		&WorkerConfig{},
 		&WorkerStatus{},
+		&BackupBucketConfig{},
+		&BackupBucketStatus{},
+		&WorkloadIdentityConfig{},
 	)
 	return nil
 }
@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BackupBucketConfig contains provider-specific configuration for a BackupBucket.
+type BackupBucketConfig struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Replication configures an optional secondary bucket the backups are replicated to.
+	// +optional
+	Replication *BackupBucketReplication `json:"replication,omitempty"`
+}
+
+// BackupBucketReplication describes the secondary bucket backups are replicated to.
+type BackupBucketReplication struct {
+	// Region is the region of the secondary bucket.
+	Region string `json:"region"`
+	// SecretRef references a secret containing the kubeconfig and namespace for the ironcore API of the secondary region.
+	SecretRef corev1.SecretReference `json:"secretRef"`
+	// BucketClassName is the name of the BucketClass used for the secondary bucket. Defaults to the bucket class
+	// configured for the primary bucket.
+	// +optional
+	BucketClassName *string `json:"bucketClassName,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BackupBucketStatus contains information about the BackupBucket.
+type BackupBucketStatus struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Replication contains the state of the replication to the secondary bucket.
+	// +optional
+	Replication *BackupBucketReplicationStatus `json:"replication,omitempty"`
+}
+
+// BackupBucketReplicationStatus contains the state of the replication to the secondary bucket.
+type BackupBucketReplicationStatus struct {
+	// Region is the region of the secondary bucket.
+	Region string `json:"region"`
+	// BucketName is the name of the secondary bucket.
+	BucketName string `json:"bucketName"`
+	// SecretRef references the secret for the ironcore API of the secondary region the bucket was created with.
+	// +optional
+	SecretRef corev1.SecretReference `json:"secretRef,omitempty"`
+	// LastReplicationTime is the time the last replication run finished.
+	// +optional
+	LastReplicationTime *metav1.Time `json:"lastReplicationTime,omitempty"`
+	// PendingObjects is the number of objects which have not been replicated yet.
+	// +optional
+	PendingObjects int32 `json:"pendingObjects,omitempty"`
+	// Lag is the age of the oldest object which has not been replicated yet.
+	// +optional
+	Lag *metav1.Duration `json:"lag,omitempty"`
+}
@@ -4,6 +4,8 @@
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -22,6 +24,9 @@ type CloudProfileConfig struct {
 	// StorageClasses defines the DefaultStrorageClass and AdditionalStoreClasses for the shoot
 	// +optional
 	StorageClasses StorageClasses `json:"storageClasses,omitempty"`
+	// VolumeSnapshotClasses defines the VolumeSnapshotClasses which are deployed to shoots that enable volume snapshots.
+	// +optional
+	VolumeSnapshotClasses []VolumeSnapshotClass `json:"volumeSnapshotClasses,omitempty"`
 }
 
 // StorageClasses is a definition of a storageClasses
@@ -32,6 +37,10 @@ type StorageClasses struct {
 	// Additional defines the additional storage classes for the shoot
 	// +optional
 	Additional []StorageClass `json:"additional,omitempty"`
+	// DiscoverVolumeClasses generates a StorageClass for every VolumeClass visible to the shoot credentials
+	// which is not referenced by the Default or Additional storage classes.
+	// +optional
+	DiscoverVolumeClasses bool `json:"discoverVolumeClasses,omitempty"`
 }
 
 // StorageClass is a definition of a storageClass
@@ -40,8 +49,51 @@ type StorageClass struct {
 	Name string `json:"name"`
 	// Type is referring to the VolumeClass to use for this StorageClass
 	Type string `json:"type"`
+	// ReclaimPolicy is the reclaim policy of the PersistentVolumes created by this StorageClass. Defaults to Delete.
+	// +optional
+	ReclaimPolicy *corev1.PersistentVolumeReclaimPolicy `json:"reclaimPolicy,omitempty"`
+	// VolumeBindingMode is the binding mode of this StorageClass. Defaults to WaitForFirstConsumer.
+	// +optional
+	VolumeBindingMode *storagev1.VolumeBindingMode `json:"volumeBindingMode,omitempty"`
+	// MountOptions are the mount options of the PersistentVolumes created by this StorageClass.
+	// +optional
+	MountOptions []string `json:"mountOptions,omitempty"`
+	// Encrypted requests encrypted ironcore volumes for this StorageClass.
+	// +optional
+	Encrypted *bool `json:"encrypted,omitempty"`
+	// AllowedZones restricts the provisioning of volumes to the given zones.
+	// +optional
+	AllowedZones []string `json:"allowedZones,omitempty"`
+	// Parameters are additional parameters passed to the CSI driver.
+	// +optional
+	Parameters map[string]string `json:"parameters,omitempty"`
+}
+
+// VolumeSnapshotClass is a definition of a volumeSnapshotClass
+type VolumeSnapshotClass struct {
+	// Name is the name of the volumeSnapshotClass
+	Name string `json:"name"`
+	// Default marks this volumeSnapshotClass as the default one for the shoot
+	// +optional
+	Default *bool `json:"default,omitempty"`
+	// DeletionPolicy defines whether the ironcore snapshot is retained or deleted together with the VolumeSnapshotContent
+	// +optional
+	DeletionPolicy *VolumeSnapshotDeletionPolicy `json:"deletionPolicy,omitempty"`
+	// Parameters are passed to the CSI driver when creating a snapshot
+	// +optional
+	Parameters map[string]string `json:"parameters,omitempty"`
 }
 
+// VolumeSnapshotDeletionPolicy is the deletion policy of a VolumeSnapshotClass.
+type VolumeSnapshotDeletionPolicy string
+
+const (
+	// VolumeSnapshotDeletionPolicyDelete deletes the ironcore snapshot once the VolumeSnapshotContent is deleted.
+	VolumeSnapshotDeletionPolicyDelete VolumeSnapshotDeletionPolicy = "Delete"
+	// VolumeSnapshotDeletionPolicyRetain keeps the ironcore snapshot once the VolumeSnapshotContent is deleted.
+	VolumeSnapshotDeletionPolicyRetain VolumeSnapshotDeletionPolicy = "Retain"
+)
+
 // MachineImages is a mapping from logical names and versions to provider-specific identifiers.
 type MachineImages struct {
 	// Name is the logical name of the machine image.
@@ -58,6 +110,31 @@ type RegionConfig struct {
 	Server string `json:"server"`
 	// CertificateAuthorityData is the base64-encoded CA data of the region server.
 	CertificateAuthorityData []byte `json:"certificateAuthorityData"`
+	// FailoverServers is an ordered list of additional server endpoints of this region. They are tried in order
+	// if Server is not reachable and have to be served with the same certificate authority.
+	// +optional
+	FailoverServers []string `json:"failoverServers,omitempty"`
+	// ProxyURL is the URL of the proxy used to connect to the servers of this region.
+	// +optional
+	ProxyURL *string `json:"proxyURL,omitempty"`
+	// TLSServerName is the server name used to verify the serving certificate of the servers of this region.
+	// +optional
+	TLSServerName *string `json:"tlsServerName,omitempty"`
+	// Zones contains the ironcore specific configuration of the zones of this region.
+	// +optional
+	Zones []ZoneConfig `json:"zones,omitempty"`
+}
+
+// ZoneConfig is the definition of a zone of a region.
+type ZoneConfig struct {
+	// Name is the name of the zone.
+	Name string `json:"name"`
+	// VolumePoolName is the name of the VolumePool in which volumes of this zone are created.
+	// +optional
+	VolumePoolName *string `json:"volumePoolName,omitempty"`
+	// MachinePoolSelector selects the MachinePools in which the machines of this zone are created.
+	// +optional
+	MachinePoolSelector map[string]string `json:"machinePoolSelector,omitempty"`
 }
 
 // MachineImageVersion contains a version and a provider-specific identifier.
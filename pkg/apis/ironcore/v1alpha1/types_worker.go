@@ -4,11 +4,63 @@
 package v1alpha1
 
 import (
+	commonv1alpha1 "github.com/ironcore-dev/ironcore/api/common/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
+// WorkerConfig contains configuration settings for the worker nodes of a worker pool.
+type WorkerConfig struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Priority is the priority of the MachineDeployments of the worker pool for the priority expander of the
+	// cluster-autoscaler. It takes precedence over the priority of the worker pool in the shoot.
+	// +optional
+	Priority *int32 `json:"priority,omitempty"`
+	// ZonePriorities overrides the priority of the MachineDeployments of individual zones of the worker pool.
+	// +optional
+	ZonePriorities []ZonePriority `json:"zonePriorities,omitempty"`
+	// NetworkInterfaces are additional network interfaces which are attached to the machines of the worker pool next
+	// to the network interface in the network of the shoot.
+	// +optional
+	NetworkInterfaces []NetworkInterfaceConfig `json:"networkInterfaces,omitempty"`
+	// KubeletConfigFromMachineClass derives the kube reserved resources and the max pods of the kubelet from the CPU and
+	// memory of the ironcore MachineClass of the worker pool. Values configured for the kubelet of the worker pool or
+	// the shoot take precedence.
+	// +optional
+	KubeletConfigFromMachineClass *bool `json:"kubeletConfigFromMachineClass,omitempty"`
+}
+
+// ZonePriority is the priority of the MachineDeployment of a zone of a worker pool.
+type ZonePriority struct {
+	// Zone is the name of the zone.
+	Zone string `json:"zone"`
+	// Priority is the priority of the MachineDeployment of the zone.
+	Priority int32 `json:"priority"`
+}
+
+// NetworkInterfaceConfig is an additional network interface of the machines of a worker pool.
+type NetworkInterfaceConfig struct {
+	// Name is the name of the network interface within the machine.
+	Name string `json:"name"`
+	// NetworkName is the name of the ironcore Network in the namespace of the shoot the network interface is
+	// attached to.
+	NetworkName string `json:"networkName"`
+	// Prefixes are static prefixes which are routed to the network interface in addition to its IP. They are only
+	// allowed for worker pools which never run more than one machine.
+	// +optional
+	Prefixes []string `json:"prefixes,omitempty"`
+	// Labels are added to the ironcore NetworkInterface, so that ironcore NetworkPolicies of the network can select
+	// it.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
 // WorkerStatus contains information about created worker resources.
 type WorkerStatus struct {
 	metav1.TypeMeta `json:",inline"`
@@ -20,6 +72,40 @@ type WorkerStatus struct {
 	// reconciliation is possible.
 	// +optional
 	MachineImages []MachineImage `json:"machineImages,omitempty"`
+	// Machines contains the ironcore resources of the machines of the worker. It is recorded when the worker is
+	// migrated, so that the restored machines can be checked against the ironcore resources they were running on.
+	// +optional
+	Machines []MachineState `json:"machines,omitempty"`
+	// KubeletConfigs contains the kubelet configurations derived from the ironcore MachineClasses of the worker pools
+	// which enable KubeletConfigFromMachineClass.
+	// +optional
+	KubeletConfigs []WorkerPoolKubeletConfig `json:"kubeletConfigs,omitempty"`
+}
+
+// WorkerPoolKubeletConfig is the kubelet configuration derived from the ironcore MachineClass of a worker pool.
+type WorkerPoolKubeletConfig struct {
+	// PoolName is the name of the worker pool.
+	PoolName string `json:"poolName"`
+	// KubeReserved are the resources reserved for kubernetes node components.
+	// +optional
+	KubeReserved corev1.ResourceList `json:"kubeReserved,omitempty"`
+	// MaxPods is the maximum number of pods of a node.
+	// +optional
+	MaxPods *int32 `json:"maxPods,omitempty"`
+}
+
+// MachineState contains the UIDs of the ironcore resources of a machine.
+type MachineState struct {
+	// Name is the name of the machine and its ironcore Machine.
+	Name string `json:"name"`
+	// UID is the UID of the ironcore Machine.
+	UID types.UID `json:"uid"`
+	// NetworkInterfaceRefs reference the ironcore NetworkInterfaces of the machine.
+	// +optional
+	NetworkInterfaceRefs []commonv1alpha1.LocalUIDReference `json:"networkInterfaceRefs,omitempty"`
+	// VolumeRefs reference the ironcore Volumes of the machine.
+	// +optional
+	VolumeRefs []commonv1alpha1.LocalUIDReference `json:"volumeRefs,omitempty"`
 }
 
 // MachineImage is a mapping from logical names and versions to ironcore-specific identifiers.
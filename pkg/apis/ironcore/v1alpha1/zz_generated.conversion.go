@@ -14,9 +14,12 @@ import (
 
 	ironcore "github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/apis/ironcore"
 	commonv1alpha1 "github.com/ironcore-dev/ironcore/api/common/v1alpha1"
-	v1 "k8s.io/api/core/v1"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	types "k8s.io/apimachinery/pkg/types"
 )
 
 func init() {
@@ -26,6 +29,46 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*BackupBucketConfig)(nil), (*ironcore.BackupBucketConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BackupBucketConfig_To_ironcore_BackupBucketConfig(a.(*BackupBucketConfig), b.(*ironcore.BackupBucketConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ironcore.BackupBucketConfig)(nil), (*BackupBucketConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_ironcore_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(a.(*ironcore.BackupBucketConfig), b.(*BackupBucketConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BackupBucketReplication)(nil), (*ironcore.BackupBucketReplication)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BackupBucketReplication_To_ironcore_BackupBucketReplication(a.(*BackupBucketReplication), b.(*ironcore.BackupBucketReplication), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ironcore.BackupBucketReplication)(nil), (*BackupBucketReplication)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_ironcore_BackupBucketReplication_To_v1alpha1_BackupBucketReplication(a.(*ironcore.BackupBucketReplication), b.(*BackupBucketReplication), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BackupBucketReplicationStatus)(nil), (*ironcore.BackupBucketReplicationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BackupBucketReplicationStatus_To_ironcore_BackupBucketReplicationStatus(a.(*BackupBucketReplicationStatus), b.(*ironcore.BackupBucketReplicationStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ironcore.BackupBucketReplicationStatus)(nil), (*BackupBucketReplicationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_ironcore_BackupBucketReplicationStatus_To_v1alpha1_BackupBucketReplicationStatus(a.(*ironcore.BackupBucketReplicationStatus), b.(*BackupBucketReplicationStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BackupBucketStatus)(nil), (*ironcore.BackupBucketStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BackupBucketStatus_To_ironcore_BackupBucketStatus(a.(*BackupBucketStatus), b.(*ironcore.BackupBucketStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ironcore.BackupBucketStatus)(nil), (*BackupBucketStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_ironcore_BackupBucketStatus_To_v1alpha1_BackupBucketStatus(a.(*ironcore.BackupBucketStatus), b.(*BackupBucketStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CloudControllerManagerConfig)(nil), (*ironcore.CloudControllerManagerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_CloudControllerManagerConfig_To_ironcore_CloudControllerManagerConfig(a.(*CloudControllerManagerConfig), b.(*ironcore.CloudControllerManagerConfig), scope)
 	}); err != nil {
@@ -76,6 +119,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*LoadBalancerConfig)(nil), (*ironcore.LoadBalancerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LoadBalancerConfig_To_ironcore_LoadBalancerConfig(a.(*LoadBalancerConfig), b.(*ironcore.LoadBalancerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ironcore.LoadBalancerConfig)(nil), (*LoadBalancerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_ironcore_LoadBalancerConfig_To_v1alpha1_LoadBalancerConfig(a.(*ironcore.LoadBalancerConfig), b.(*LoadBalancerConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MachineImage)(nil), (*ironcore.MachineImage)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_MachineImage_To_ironcore_MachineImage(a.(*MachineImage), b.(*ironcore.MachineImage), scope)
 	}); err != nil {
@@ -106,6 +159,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MachineState)(nil), (*ironcore.MachineState)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MachineState_To_ironcore_MachineState(a.(*MachineState), b.(*ironcore.MachineState), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ironcore.MachineState)(nil), (*MachineState)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_ironcore_MachineState_To_v1alpha1_MachineState(a.(*ironcore.MachineState), b.(*MachineState), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NetworkInterfaceConfig)(nil), (*ironcore.NetworkInterfaceConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NetworkInterfaceConfig_To_ironcore_NetworkInterfaceConfig(a.(*NetworkInterfaceConfig), b.(*ironcore.NetworkInterfaceConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ironcore.NetworkInterfaceConfig)(nil), (*NetworkInterfaceConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_ironcore_NetworkInterfaceConfig_To_v1alpha1_NetworkInterfaceConfig(a.(*ironcore.NetworkInterfaceConfig), b.(*NetworkInterfaceConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*RegionConfig)(nil), (*ironcore.RegionConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_RegionConfig_To_ironcore_RegionConfig(a.(*RegionConfig), b.(*ironcore.RegionConfig), scope)
 	}); err != nil {
@@ -116,6 +189,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*RouteControllerConfig)(nil), (*ironcore.RouteControllerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RouteControllerConfig_To_ironcore_RouteControllerConfig(a.(*RouteControllerConfig), b.(*ironcore.RouteControllerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ironcore.RouteControllerConfig)(nil), (*RouteControllerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_ironcore_RouteControllerConfig_To_v1alpha1_RouteControllerConfig(a.(*ironcore.RouteControllerConfig), b.(*RouteControllerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Storage)(nil), (*ironcore.Storage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Storage_To_ironcore_Storage(a.(*Storage), b.(*ironcore.Storage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ironcore.Storage)(nil), (*Storage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_ironcore_Storage_To_v1alpha1_Storage(a.(*ironcore.Storage), b.(*Storage), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*StorageClass)(nil), (*ironcore.StorageClass)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_StorageClass_To_ironcore_StorageClass(a.(*StorageClass), b.(*ironcore.StorageClass), scope)
 	}); err != nil {
@@ -136,6 +229,56 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*VirtualIPConfig)(nil), (*ironcore.VirtualIPConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VirtualIPConfig_To_ironcore_VirtualIPConfig(a.(*VirtualIPConfig), b.(*ironcore.VirtualIPConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ironcore.VirtualIPConfig)(nil), (*VirtualIPConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_ironcore_VirtualIPConfig_To_v1alpha1_VirtualIPConfig(a.(*ironcore.VirtualIPConfig), b.(*VirtualIPConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VolumeSnapshotClass)(nil), (*ironcore.VolumeSnapshotClass)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VolumeSnapshotClass_To_ironcore_VolumeSnapshotClass(a.(*VolumeSnapshotClass), b.(*ironcore.VolumeSnapshotClass), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ironcore.VolumeSnapshotClass)(nil), (*VolumeSnapshotClass)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_ironcore_VolumeSnapshotClass_To_v1alpha1_VolumeSnapshotClass(a.(*ironcore.VolumeSnapshotClass), b.(*VolumeSnapshotClass), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VolumeSnapshots)(nil), (*ironcore.VolumeSnapshots)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VolumeSnapshots_To_ironcore_VolumeSnapshots(a.(*VolumeSnapshots), b.(*ironcore.VolumeSnapshots), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ironcore.VolumeSnapshots)(nil), (*VolumeSnapshots)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_ironcore_VolumeSnapshots_To_v1alpha1_VolumeSnapshots(a.(*ironcore.VolumeSnapshots), b.(*VolumeSnapshots), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*WorkerConfig)(nil), (*ironcore.WorkerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WorkerConfig_To_ironcore_WorkerConfig(a.(*WorkerConfig), b.(*ironcore.WorkerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ironcore.WorkerConfig)(nil), (*WorkerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_ironcore_WorkerConfig_To_v1alpha1_WorkerConfig(a.(*ironcore.WorkerConfig), b.(*WorkerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*WorkerPoolKubeletConfig)(nil), (*ironcore.WorkerPoolKubeletConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WorkerPoolKubeletConfig_To_ironcore_WorkerPoolKubeletConfig(a.(*WorkerPoolKubeletConfig), b.(*ironcore.WorkerPoolKubeletConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ironcore.WorkerPoolKubeletConfig)(nil), (*WorkerPoolKubeletConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_ironcore_WorkerPoolKubeletConfig_To_v1alpha1_WorkerPoolKubeletConfig(a.(*ironcore.WorkerPoolKubeletConfig), b.(*WorkerPoolKubeletConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*WorkerStatus)(nil), (*ironcore.WorkerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_WorkerStatus_To_ironcore_WorkerStatus(a.(*WorkerStatus), b.(*ironcore.WorkerStatus), scope)
 	}); err != nil {
@@ -146,11 +289,137 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*WorkloadIdentityConfig)(nil), (*ironcore.WorkloadIdentityConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WorkloadIdentityConfig_To_ironcore_WorkloadIdentityConfig(a.(*WorkloadIdentityConfig), b.(*ironcore.WorkloadIdentityConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ironcore.WorkloadIdentityConfig)(nil), (*WorkloadIdentityConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_ironcore_WorkloadIdentityConfig_To_v1alpha1_WorkloadIdentityConfig(a.(*ironcore.WorkloadIdentityConfig), b.(*WorkloadIdentityConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ZoneConfig)(nil), (*ironcore.ZoneConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ZoneConfig_To_ironcore_ZoneConfig(a.(*ZoneConfig), b.(*ironcore.ZoneConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ironcore.ZoneConfig)(nil), (*ZoneConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_ironcore_ZoneConfig_To_v1alpha1_ZoneConfig(a.(*ironcore.ZoneConfig), b.(*ZoneConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ZonePriority)(nil), (*ironcore.ZonePriority)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ZonePriority_To_ironcore_ZonePriority(a.(*ZonePriority), b.(*ironcore.ZonePriority), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ironcore.ZonePriority)(nil), (*ZonePriority)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_ironcore_ZonePriority_To_v1alpha1_ZonePriority(a.(*ironcore.ZonePriority), b.(*ZonePriority), scope)
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1alpha1_BackupBucketConfig_To_ironcore_BackupBucketConfig(in *BackupBucketConfig, out *ironcore.BackupBucketConfig, s conversion.Scope) error {
+	out.Replication = (*ironcore.BackupBucketReplication)(unsafe.Pointer(in.Replication))
+	return nil
+}
+
+// Convert_v1alpha1_BackupBucketConfig_To_ironcore_BackupBucketConfig is an autogenerated conversion function.
+func Convert_v1alpha1_BackupBucketConfig_To_ironcore_BackupBucketConfig(in *BackupBucketConfig, out *ironcore.BackupBucketConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BackupBucketConfig_To_ironcore_BackupBucketConfig(in, out, s)
+}
+
+func autoConvert_ironcore_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(in *ironcore.BackupBucketConfig, out *BackupBucketConfig, s conversion.Scope) error {
+	out.Replication = (*BackupBucketReplication)(unsafe.Pointer(in.Replication))
+	return nil
+}
+
+// Convert_ironcore_BackupBucketConfig_To_v1alpha1_BackupBucketConfig is an autogenerated conversion function.
+func Convert_ironcore_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(in *ironcore.BackupBucketConfig, out *BackupBucketConfig, s conversion.Scope) error {
+	return autoConvert_ironcore_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_BackupBucketReplication_To_ironcore_BackupBucketReplication(in *BackupBucketReplication, out *ironcore.BackupBucketReplication, s conversion.Scope) error {
+	out.Region = in.Region
+	out.SecretRef = in.SecretRef
+	out.BucketClassName = (*string)(unsafe.Pointer(in.BucketClassName))
+	return nil
+}
+
+// Convert_v1alpha1_BackupBucketReplication_To_ironcore_BackupBucketReplication is an autogenerated conversion function.
+func Convert_v1alpha1_BackupBucketReplication_To_ironcore_BackupBucketReplication(in *BackupBucketReplication, out *ironcore.BackupBucketReplication, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BackupBucketReplication_To_ironcore_BackupBucketReplication(in, out, s)
+}
+
+func autoConvert_ironcore_BackupBucketReplication_To_v1alpha1_BackupBucketReplication(in *ironcore.BackupBucketReplication, out *BackupBucketReplication, s conversion.Scope) error {
+	out.Region = in.Region
+	out.SecretRef = in.SecretRef
+	out.BucketClassName = (*string)(unsafe.Pointer(in.BucketClassName))
+	return nil
+}
+
+// Convert_ironcore_BackupBucketReplication_To_v1alpha1_BackupBucketReplication is an autogenerated conversion function.
+func Convert_ironcore_BackupBucketReplication_To_v1alpha1_BackupBucketReplication(in *ironcore.BackupBucketReplication, out *BackupBucketReplication, s conversion.Scope) error {
+	return autoConvert_ironcore_BackupBucketReplication_To_v1alpha1_BackupBucketReplication(in, out, s)
+}
+
+func autoConvert_v1alpha1_BackupBucketReplicationStatus_To_ironcore_BackupBucketReplicationStatus(in *BackupBucketReplicationStatus, out *ironcore.BackupBucketReplicationStatus, s conversion.Scope) error {
+	out.Region = in.Region
+	out.BucketName = in.BucketName
+	out.SecretRef = in.SecretRef
+	out.LastReplicationTime = (*v1.Time)(unsafe.Pointer(in.LastReplicationTime))
+	out.PendingObjects = in.PendingObjects
+	out.Lag = (*v1.Duration)(unsafe.Pointer(in.Lag))
+	return nil
+}
+
+// Convert_v1alpha1_BackupBucketReplicationStatus_To_ironcore_BackupBucketReplicationStatus is an autogenerated conversion function.
+func Convert_v1alpha1_BackupBucketReplicationStatus_To_ironcore_BackupBucketReplicationStatus(in *BackupBucketReplicationStatus, out *ironcore.BackupBucketReplicationStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BackupBucketReplicationStatus_To_ironcore_BackupBucketReplicationStatus(in, out, s)
+}
+
+func autoConvert_ironcore_BackupBucketReplicationStatus_To_v1alpha1_BackupBucketReplicationStatus(in *ironcore.BackupBucketReplicationStatus, out *BackupBucketReplicationStatus, s conversion.Scope) error {
+	out.Region = in.Region
+	out.BucketName = in.BucketName
+	out.SecretRef = in.SecretRef
+	out.LastReplicationTime = (*v1.Time)(unsafe.Pointer(in.LastReplicationTime))
+	out.PendingObjects = in.PendingObjects
+	out.Lag = (*v1.Duration)(unsafe.Pointer(in.Lag))
+	return nil
+}
+
+// Convert_ironcore_BackupBucketReplicationStatus_To_v1alpha1_BackupBucketReplicationStatus is an autogenerated conversion function.
+func Convert_ironcore_BackupBucketReplicationStatus_To_v1alpha1_BackupBucketReplicationStatus(in *ironcore.BackupBucketReplicationStatus, out *BackupBucketReplicationStatus, s conversion.Scope) error {
+	return autoConvert_ironcore_BackupBucketReplicationStatus_To_v1alpha1_BackupBucketReplicationStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_BackupBucketStatus_To_ironcore_BackupBucketStatus(in *BackupBucketStatus, out *ironcore.BackupBucketStatus, s conversion.Scope) error {
+	out.Replication = (*ironcore.BackupBucketReplicationStatus)(unsafe.Pointer(in.Replication))
+	return nil
+}
+
+// Convert_v1alpha1_BackupBucketStatus_To_ironcore_BackupBucketStatus is an autogenerated conversion function.
+func Convert_v1alpha1_BackupBucketStatus_To_ironcore_BackupBucketStatus(in *BackupBucketStatus, out *ironcore.BackupBucketStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BackupBucketStatus_To_ironcore_BackupBucketStatus(in, out, s)
+}
+
+func autoConvert_ironcore_BackupBucketStatus_To_v1alpha1_BackupBucketStatus(in *ironcore.BackupBucketStatus, out *BackupBucketStatus, s conversion.Scope) error {
+	out.Replication = (*BackupBucketReplicationStatus)(unsafe.Pointer(in.Replication))
 	return nil
 }
 
+// Convert_ironcore_BackupBucketStatus_To_v1alpha1_BackupBucketStatus is an autogenerated conversion function.
+func Convert_ironcore_BackupBucketStatus_To_v1alpha1_BackupBucketStatus(in *ironcore.BackupBucketStatus, out *BackupBucketStatus, s conversion.Scope) error {
+	return autoConvert_ironcore_BackupBucketStatus_To_v1alpha1_BackupBucketStatus(in, out, s)
+}
+
 func autoConvert_v1alpha1_CloudControllerManagerConfig_To_ironcore_CloudControllerManagerConfig(in *CloudControllerManagerConfig, out *ironcore.CloudControllerManagerConfig, s conversion.Scope) error {
 	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
+	out.LoadBalancer = (*ironcore.LoadBalancerConfig)(unsafe.Pointer(in.LoadBalancer))
+	out.RouteController = (*ironcore.RouteControllerConfig)(unsafe.Pointer(in.RouteController))
 	return nil
 }
 
@@ -161,6 +430,8 @@ func Convert_v1alpha1_CloudControllerManagerConfig_To_ironcore_CloudControllerMa
 
 func autoConvert_ironcore_CloudControllerManagerConfig_To_v1alpha1_CloudControllerManagerConfig(in *ironcore.CloudControllerManagerConfig, out *CloudControllerManagerConfig, s conversion.Scope) error {
 	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
+	out.LoadBalancer = (*LoadBalancerConfig)(unsafe.Pointer(in.LoadBalancer))
+	out.RouteController = (*RouteControllerConfig)(unsafe.Pointer(in.RouteController))
 	return nil
 }
 
@@ -175,6 +446,7 @@ func autoConvert_v1alpha1_CloudProfileConfig_To_ironcore_CloudProfileConfig(in *
 	if err := Convert_v1alpha1_StorageClasses_To_ironcore_StorageClasses(&in.StorageClasses, &out.StorageClasses, s); err != nil {
 		return err
 	}
+	out.VolumeSnapshotClasses = *(*[]ironcore.VolumeSnapshotClass)(unsafe.Pointer(&in.VolumeSnapshotClasses))
 	return nil
 }
 
@@ -189,6 +461,7 @@ func autoConvert_ironcore_CloudProfileConfig_To_v1alpha1_CloudProfileConfig(in *
 	if err := Convert_ironcore_StorageClasses_To_v1alpha1_StorageClasses(&in.StorageClasses, &out.StorageClasses, s); err != nil {
 		return err
 	}
+	out.VolumeSnapshotClasses = *(*[]VolumeSnapshotClass)(unsafe.Pointer(&in.VolumeSnapshotClasses))
 	return nil
 }
 
@@ -199,6 +472,7 @@ func Convert_ironcore_CloudProfileConfig_To_v1alpha1_CloudProfileConfig(in *iron
 
 func autoConvert_v1alpha1_ControlPlaneConfig_To_ironcore_ControlPlaneConfig(in *ControlPlaneConfig, out *ironcore.ControlPlaneConfig, s conversion.Scope) error {
 	out.CloudControllerManager = (*ironcore.CloudControllerManagerConfig)(unsafe.Pointer(in.CloudControllerManager))
+	out.Storage = (*ironcore.Storage)(unsafe.Pointer(in.Storage))
 	return nil
 }
 
@@ -209,6 +483,7 @@ func Convert_v1alpha1_ControlPlaneConfig_To_ironcore_ControlPlaneConfig(in *Cont
 
 func autoConvert_ironcore_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in *ironcore.ControlPlaneConfig, out *ControlPlaneConfig, s conversion.Scope) error {
 	out.CloudControllerManager = (*CloudControllerManagerConfig)(unsafe.Pointer(in.CloudControllerManager))
+	out.Storage = (*Storage)(unsafe.Pointer(in.Storage))
 	return nil
 }
 
@@ -218,7 +493,7 @@ func Convert_ironcore_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in *iron
 }
 
 func autoConvert_v1alpha1_InfrastructureConfig_To_ironcore_InfrastructureConfig(in *InfrastructureConfig, out *ironcore.InfrastructureConfig, s conversion.Scope) error {
-	out.NetworkRef = (*v1.LocalObjectReference)(unsafe.Pointer(in.NetworkRef))
+	out.NetworkRef = (*corev1.LocalObjectReference)(unsafe.Pointer(in.NetworkRef))
 	out.NATPortsPerNetworkInterface = (*int32)(unsafe.Pointer(in.NATPortsPerNetworkInterface))
 	out.NetworkPolicyRef = (*commonv1alpha1.LocalUIDReference)(unsafe.Pointer(in.NetworkPolicyRef))
 	return nil
@@ -230,7 +505,7 @@ func Convert_v1alpha1_InfrastructureConfig_To_ironcore_InfrastructureConfig(in *
 }
 
 func autoConvert_ironcore_InfrastructureConfig_To_v1alpha1_InfrastructureConfig(in *ironcore.InfrastructureConfig, out *InfrastructureConfig, s conversion.Scope) error {
-	out.NetworkRef = (*v1.LocalObjectReference)(unsafe.Pointer(in.NetworkRef))
+	out.NetworkRef = (*corev1.LocalObjectReference)(unsafe.Pointer(in.NetworkRef))
 	out.NATPortsPerNetworkInterface = (*int32)(unsafe.Pointer(in.NATPortsPerNetworkInterface))
 	out.NetworkPolicyRef = (*commonv1alpha1.LocalUIDReference)(unsafe.Pointer(in.NetworkPolicyRef))
 	return nil
@@ -267,6 +542,30 @@ func Convert_ironcore_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(in *
 	return autoConvert_ironcore_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(in, out, s)
 }
 
+func autoConvert_v1alpha1_LoadBalancerConfig_To_ironcore_LoadBalancerConfig(in *LoadBalancerConfig, out *ironcore.LoadBalancerConfig, s conversion.Scope) error {
+	out.DefaultType = (*ironcore.LoadBalancerType)(unsafe.Pointer(in.DefaultType))
+	out.VirtualIP = (*ironcore.VirtualIPConfig)(unsafe.Pointer(in.VirtualIP))
+	out.SourceRangeEnforcement = (*ironcore.SourceRangeEnforcementMode)(unsafe.Pointer(in.SourceRangeEnforcement))
+	return nil
+}
+
+// Convert_v1alpha1_LoadBalancerConfig_To_ironcore_LoadBalancerConfig is an autogenerated conversion function.
+func Convert_v1alpha1_LoadBalancerConfig_To_ironcore_LoadBalancerConfig(in *LoadBalancerConfig, out *ironcore.LoadBalancerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LoadBalancerConfig_To_ironcore_LoadBalancerConfig(in, out, s)
+}
+
+func autoConvert_ironcore_LoadBalancerConfig_To_v1alpha1_LoadBalancerConfig(in *ironcore.LoadBalancerConfig, out *LoadBalancerConfig, s conversion.Scope) error {
+	out.DefaultType = (*LoadBalancerType)(unsafe.Pointer(in.DefaultType))
+	out.VirtualIP = (*VirtualIPConfig)(unsafe.Pointer(in.VirtualIP))
+	out.SourceRangeEnforcement = (*SourceRangeEnforcementMode)(unsafe.Pointer(in.SourceRangeEnforcement))
+	return nil
+}
+
+// Convert_ironcore_LoadBalancerConfig_To_v1alpha1_LoadBalancerConfig is an autogenerated conversion function.
+func Convert_ironcore_LoadBalancerConfig_To_v1alpha1_LoadBalancerConfig(in *ironcore.LoadBalancerConfig, out *LoadBalancerConfig, s conversion.Scope) error {
+	return autoConvert_ironcore_LoadBalancerConfig_To_v1alpha1_LoadBalancerConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_MachineImage_To_ironcore_MachineImage(in *MachineImage, out *ironcore.MachineImage, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Version = in.Version
@@ -339,10 +638,66 @@ func Convert_ironcore_MachineImages_To_v1alpha1_MachineImages(in *ironcore.Machi
 	return autoConvert_ironcore_MachineImages_To_v1alpha1_MachineImages(in, out, s)
 }
 
+func autoConvert_v1alpha1_MachineState_To_ironcore_MachineState(in *MachineState, out *ironcore.MachineState, s conversion.Scope) error {
+	out.Name = in.Name
+	out.UID = types.UID(in.UID)
+	out.NetworkInterfaceRefs = *(*[]commonv1alpha1.LocalUIDReference)(unsafe.Pointer(&in.NetworkInterfaceRefs))
+	out.VolumeRefs = *(*[]commonv1alpha1.LocalUIDReference)(unsafe.Pointer(&in.VolumeRefs))
+	return nil
+}
+
+// Convert_v1alpha1_MachineState_To_ironcore_MachineState is an autogenerated conversion function.
+func Convert_v1alpha1_MachineState_To_ironcore_MachineState(in *MachineState, out *ironcore.MachineState, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachineState_To_ironcore_MachineState(in, out, s)
+}
+
+func autoConvert_ironcore_MachineState_To_v1alpha1_MachineState(in *ironcore.MachineState, out *MachineState, s conversion.Scope) error {
+	out.Name = in.Name
+	out.UID = types.UID(in.UID)
+	out.NetworkInterfaceRefs = *(*[]commonv1alpha1.LocalUIDReference)(unsafe.Pointer(&in.NetworkInterfaceRefs))
+	out.VolumeRefs = *(*[]commonv1alpha1.LocalUIDReference)(unsafe.Pointer(&in.VolumeRefs))
+	return nil
+}
+
+// Convert_ironcore_MachineState_To_v1alpha1_MachineState is an autogenerated conversion function.
+func Convert_ironcore_MachineState_To_v1alpha1_MachineState(in *ironcore.MachineState, out *MachineState, s conversion.Scope) error {
+	return autoConvert_ironcore_MachineState_To_v1alpha1_MachineState(in, out, s)
+}
+
+func autoConvert_v1alpha1_NetworkInterfaceConfig_To_ironcore_NetworkInterfaceConfig(in *NetworkInterfaceConfig, out *ironcore.NetworkInterfaceConfig, s conversion.Scope) error {
+	out.Name = in.Name
+	out.NetworkName = in.NetworkName
+	out.Prefixes = *(*[]string)(unsafe.Pointer(&in.Prefixes))
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	return nil
+}
+
+// Convert_v1alpha1_NetworkInterfaceConfig_To_ironcore_NetworkInterfaceConfig is an autogenerated conversion function.
+func Convert_v1alpha1_NetworkInterfaceConfig_To_ironcore_NetworkInterfaceConfig(in *NetworkInterfaceConfig, out *ironcore.NetworkInterfaceConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NetworkInterfaceConfig_To_ironcore_NetworkInterfaceConfig(in, out, s)
+}
+
+func autoConvert_ironcore_NetworkInterfaceConfig_To_v1alpha1_NetworkInterfaceConfig(in *ironcore.NetworkInterfaceConfig, out *NetworkInterfaceConfig, s conversion.Scope) error {
+	out.Name = in.Name
+	out.NetworkName = in.NetworkName
+	out.Prefixes = *(*[]string)(unsafe.Pointer(&in.Prefixes))
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	return nil
+}
+
+// Convert_ironcore_NetworkInterfaceConfig_To_v1alpha1_NetworkInterfaceConfig is an autogenerated conversion function.
+func Convert_ironcore_NetworkInterfaceConfig_To_v1alpha1_NetworkInterfaceConfig(in *ironcore.NetworkInterfaceConfig, out *NetworkInterfaceConfig, s conversion.Scope) error {
+	return autoConvert_ironcore_NetworkInterfaceConfig_To_v1alpha1_NetworkInterfaceConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_RegionConfig_To_ironcore_RegionConfig(in *RegionConfig, out *ironcore.RegionConfig, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Server = in.Server
 	out.CertificateAuthorityData = *(*[]byte)(unsafe.Pointer(&in.CertificateAuthorityData))
+	out.FailoverServers = *(*[]string)(unsafe.Pointer(&in.FailoverServers))
+	out.ProxyURL = (*string)(unsafe.Pointer(in.ProxyURL))
+	out.TLSServerName = (*string)(unsafe.Pointer(in.TLSServerName))
+	out.Zones = *(*[]ironcore.ZoneConfig)(unsafe.Pointer(&in.Zones))
 	return nil
 }
 
@@ -355,6 +710,10 @@ func autoConvert_ironcore_RegionConfig_To_v1alpha1_RegionConfig(in *ironcore.Reg
 	out.Name = in.Name
 	out.Server = in.Server
 	out.CertificateAuthorityData = *(*[]byte)(unsafe.Pointer(&in.CertificateAuthorityData))
+	out.FailoverServers = *(*[]string)(unsafe.Pointer(&in.FailoverServers))
+	out.ProxyURL = (*string)(unsafe.Pointer(in.ProxyURL))
+	out.TLSServerName = (*string)(unsafe.Pointer(in.TLSServerName))
+	out.Zones = *(*[]ZoneConfig)(unsafe.Pointer(&in.Zones))
 	return nil
 }
 
@@ -363,9 +722,59 @@ func Convert_ironcore_RegionConfig_To_v1alpha1_RegionConfig(in *ironcore.RegionC
 	return autoConvert_ironcore_RegionConfig_To_v1alpha1_RegionConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_RouteControllerConfig_To_ironcore_RouteControllerConfig(in *RouteControllerConfig, out *ironcore.RouteControllerConfig, s conversion.Scope) error {
+	out.ReconciliationPeriod = (*v1.Duration)(unsafe.Pointer(in.ReconciliationPeriod))
+	out.PodPrefixMode = (*ironcore.PodPrefixMode)(unsafe.Pointer(in.PodPrefixMode))
+	return nil
+}
+
+// Convert_v1alpha1_RouteControllerConfig_To_ironcore_RouteControllerConfig is an autogenerated conversion function.
+func Convert_v1alpha1_RouteControllerConfig_To_ironcore_RouteControllerConfig(in *RouteControllerConfig, out *ironcore.RouteControllerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RouteControllerConfig_To_ironcore_RouteControllerConfig(in, out, s)
+}
+
+func autoConvert_ironcore_RouteControllerConfig_To_v1alpha1_RouteControllerConfig(in *ironcore.RouteControllerConfig, out *RouteControllerConfig, s conversion.Scope) error {
+	out.ReconciliationPeriod = (*v1.Duration)(unsafe.Pointer(in.ReconciliationPeriod))
+	out.PodPrefixMode = (*PodPrefixMode)(unsafe.Pointer(in.PodPrefixMode))
+	return nil
+}
+
+// Convert_ironcore_RouteControllerConfig_To_v1alpha1_RouteControllerConfig is an autogenerated conversion function.
+func Convert_ironcore_RouteControllerConfig_To_v1alpha1_RouteControllerConfig(in *ironcore.RouteControllerConfig, out *RouteControllerConfig, s conversion.Scope) error {
+	return autoConvert_ironcore_RouteControllerConfig_To_v1alpha1_RouteControllerConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_Storage_To_ironcore_Storage(in *Storage, out *ironcore.Storage, s conversion.Scope) error {
+	out.VolumeSnapshots = (*ironcore.VolumeSnapshots)(unsafe.Pointer(in.VolumeSnapshots))
+	out.StorageClasses = *(*[]ironcore.StorageClass)(unsafe.Pointer(&in.StorageClasses))
+	return nil
+}
+
+// Convert_v1alpha1_Storage_To_ironcore_Storage is an autogenerated conversion function.
+func Convert_v1alpha1_Storage_To_ironcore_Storage(in *Storage, out *ironcore.Storage, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Storage_To_ironcore_Storage(in, out, s)
+}
+
+func autoConvert_ironcore_Storage_To_v1alpha1_Storage(in *ironcore.Storage, out *Storage, s conversion.Scope) error {
+	out.VolumeSnapshots = (*VolumeSnapshots)(unsafe.Pointer(in.VolumeSnapshots))
+	out.StorageClasses = *(*[]StorageClass)(unsafe.Pointer(&in.StorageClasses))
+	return nil
+}
+
+// Convert_ironcore_Storage_To_v1alpha1_Storage is an autogenerated conversion function.
+func Convert_ironcore_Storage_To_v1alpha1_Storage(in *ironcore.Storage, out *Storage, s conversion.Scope) error {
+	return autoConvert_ironcore_Storage_To_v1alpha1_Storage(in, out, s)
+}
+
 func autoConvert_v1alpha1_StorageClass_To_ironcore_StorageClass(in *StorageClass, out *ironcore.StorageClass, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Type = in.Type
+	out.ReclaimPolicy = (*corev1.PersistentVolumeReclaimPolicy)(unsafe.Pointer(in.ReclaimPolicy))
+	out.VolumeBindingMode = (*storagev1.VolumeBindingMode)(unsafe.Pointer(in.VolumeBindingMode))
+	out.MountOptions = *(*[]string)(unsafe.Pointer(&in.MountOptions))
+	out.Encrypted = (*bool)(unsafe.Pointer(in.Encrypted))
+	out.AllowedZones = *(*[]string)(unsafe.Pointer(&in.AllowedZones))
+	out.Parameters = *(*map[string]string)(unsafe.Pointer(&in.Parameters))
 	return nil
 }
 
@@ -377,6 +786,12 @@ func Convert_v1alpha1_StorageClass_To_ironcore_StorageClass(in *StorageClass, ou
 func autoConvert_ironcore_StorageClass_To_v1alpha1_StorageClass(in *ironcore.StorageClass, out *StorageClass, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Type = in.Type
+	out.ReclaimPolicy = (*corev1.PersistentVolumeReclaimPolicy)(unsafe.Pointer(in.ReclaimPolicy))
+	out.VolumeBindingMode = (*storagev1.VolumeBindingMode)(unsafe.Pointer(in.VolumeBindingMode))
+	out.MountOptions = *(*[]string)(unsafe.Pointer(&in.MountOptions))
+	out.Encrypted = (*bool)(unsafe.Pointer(in.Encrypted))
+	out.AllowedZones = *(*[]string)(unsafe.Pointer(&in.AllowedZones))
+	out.Parameters = *(*map[string]string)(unsafe.Pointer(&in.Parameters))
 	return nil
 }
 
@@ -388,6 +803,7 @@ func Convert_ironcore_StorageClass_To_v1alpha1_StorageClass(in *ironcore.Storage
 func autoConvert_v1alpha1_StorageClasses_To_ironcore_StorageClasses(in *StorageClasses, out *ironcore.StorageClasses, s conversion.Scope) error {
 	out.Default = (*ironcore.StorageClass)(unsafe.Pointer(in.Default))
 	out.Additional = *(*[]ironcore.StorageClass)(unsafe.Pointer(&in.Additional))
+	out.DiscoverVolumeClasses = in.DiscoverVolumeClasses
 	return nil
 }
 
@@ -399,6 +815,7 @@ func Convert_v1alpha1_StorageClasses_To_ironcore_StorageClasses(in *StorageClass
 func autoConvert_ironcore_StorageClasses_To_v1alpha1_StorageClasses(in *ironcore.StorageClasses, out *StorageClasses, s conversion.Scope) error {
 	out.Default = (*StorageClass)(unsafe.Pointer(in.Default))
 	out.Additional = *(*[]StorageClass)(unsafe.Pointer(&in.Additional))
+	out.DiscoverVolumeClasses = in.DiscoverVolumeClasses
 	return nil
 }
 
@@ -407,8 +824,128 @@ func Convert_ironcore_StorageClasses_To_v1alpha1_StorageClasses(in *ironcore.Sto
 	return autoConvert_ironcore_StorageClasses_To_v1alpha1_StorageClasses(in, out, s)
 }
 
+func autoConvert_v1alpha1_VirtualIPConfig_To_ironcore_VirtualIPConfig(in *VirtualIPConfig, out *ironcore.VirtualIPConfig, s conversion.Scope) error {
+	out.Prefix = (*string)(unsafe.Pointer(in.Prefix))
+	out.PoolRef = (*corev1.LocalObjectReference)(unsafe.Pointer(in.PoolRef))
+	return nil
+}
+
+// Convert_v1alpha1_VirtualIPConfig_To_ironcore_VirtualIPConfig is an autogenerated conversion function.
+func Convert_v1alpha1_VirtualIPConfig_To_ironcore_VirtualIPConfig(in *VirtualIPConfig, out *ironcore.VirtualIPConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VirtualIPConfig_To_ironcore_VirtualIPConfig(in, out, s)
+}
+
+func autoConvert_ironcore_VirtualIPConfig_To_v1alpha1_VirtualIPConfig(in *ironcore.VirtualIPConfig, out *VirtualIPConfig, s conversion.Scope) error {
+	out.Prefix = (*string)(unsafe.Pointer(in.Prefix))
+	out.PoolRef = (*corev1.LocalObjectReference)(unsafe.Pointer(in.PoolRef))
+	return nil
+}
+
+// Convert_ironcore_VirtualIPConfig_To_v1alpha1_VirtualIPConfig is an autogenerated conversion function.
+func Convert_ironcore_VirtualIPConfig_To_v1alpha1_VirtualIPConfig(in *ironcore.VirtualIPConfig, out *VirtualIPConfig, s conversion.Scope) error {
+	return autoConvert_ironcore_VirtualIPConfig_To_v1alpha1_VirtualIPConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_VolumeSnapshotClass_To_ironcore_VolumeSnapshotClass(in *VolumeSnapshotClass, out *ironcore.VolumeSnapshotClass, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Default = (*bool)(unsafe.Pointer(in.Default))
+	out.DeletionPolicy = (*ironcore.VolumeSnapshotDeletionPolicy)(unsafe.Pointer(in.DeletionPolicy))
+	out.Parameters = *(*map[string]string)(unsafe.Pointer(&in.Parameters))
+	return nil
+}
+
+// Convert_v1alpha1_VolumeSnapshotClass_To_ironcore_VolumeSnapshotClass is an autogenerated conversion function.
+func Convert_v1alpha1_VolumeSnapshotClass_To_ironcore_VolumeSnapshotClass(in *VolumeSnapshotClass, out *ironcore.VolumeSnapshotClass, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VolumeSnapshotClass_To_ironcore_VolumeSnapshotClass(in, out, s)
+}
+
+func autoConvert_ironcore_VolumeSnapshotClass_To_v1alpha1_VolumeSnapshotClass(in *ironcore.VolumeSnapshotClass, out *VolumeSnapshotClass, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Default = (*bool)(unsafe.Pointer(in.Default))
+	out.DeletionPolicy = (*VolumeSnapshotDeletionPolicy)(unsafe.Pointer(in.DeletionPolicy))
+	out.Parameters = *(*map[string]string)(unsafe.Pointer(&in.Parameters))
+	return nil
+}
+
+// Convert_ironcore_VolumeSnapshotClass_To_v1alpha1_VolumeSnapshotClass is an autogenerated conversion function.
+func Convert_ironcore_VolumeSnapshotClass_To_v1alpha1_VolumeSnapshotClass(in *ironcore.VolumeSnapshotClass, out *VolumeSnapshotClass, s conversion.Scope) error {
+	return autoConvert_ironcore_VolumeSnapshotClass_To_v1alpha1_VolumeSnapshotClass(in, out, s)
+}
+
+func autoConvert_v1alpha1_VolumeSnapshots_To_ironcore_VolumeSnapshots(in *VolumeSnapshots, out *ironcore.VolumeSnapshots, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_v1alpha1_VolumeSnapshots_To_ironcore_VolumeSnapshots is an autogenerated conversion function.
+func Convert_v1alpha1_VolumeSnapshots_To_ironcore_VolumeSnapshots(in *VolumeSnapshots, out *ironcore.VolumeSnapshots, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VolumeSnapshots_To_ironcore_VolumeSnapshots(in, out, s)
+}
+
+func autoConvert_ironcore_VolumeSnapshots_To_v1alpha1_VolumeSnapshots(in *ironcore.VolumeSnapshots, out *VolumeSnapshots, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_ironcore_VolumeSnapshots_To_v1alpha1_VolumeSnapshots is an autogenerated conversion function.
+func Convert_ironcore_VolumeSnapshots_To_v1alpha1_VolumeSnapshots(in *ironcore.VolumeSnapshots, out *VolumeSnapshots, s conversion.Scope) error {
+	return autoConvert_ironcore_VolumeSnapshots_To_v1alpha1_VolumeSnapshots(in, out, s)
+}
+
+func autoConvert_v1alpha1_WorkerConfig_To_ironcore_WorkerConfig(in *WorkerConfig, out *ironcore.WorkerConfig, s conversion.Scope) error {
+	out.Priority = (*int32)(unsafe.Pointer(in.Priority))
+	out.ZonePriorities = *(*[]ironcore.ZonePriority)(unsafe.Pointer(&in.ZonePriorities))
+	out.NetworkInterfaces = *(*[]ironcore.NetworkInterfaceConfig)(unsafe.Pointer(&in.NetworkInterfaces))
+	out.KubeletConfigFromMachineClass = (*bool)(unsafe.Pointer(in.KubeletConfigFromMachineClass))
+	return nil
+}
+
+// Convert_v1alpha1_WorkerConfig_To_ironcore_WorkerConfig is an autogenerated conversion function.
+func Convert_v1alpha1_WorkerConfig_To_ironcore_WorkerConfig(in *WorkerConfig, out *ironcore.WorkerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_WorkerConfig_To_ironcore_WorkerConfig(in, out, s)
+}
+
+func autoConvert_ironcore_WorkerConfig_To_v1alpha1_WorkerConfig(in *ironcore.WorkerConfig, out *WorkerConfig, s conversion.Scope) error {
+	out.Priority = (*int32)(unsafe.Pointer(in.Priority))
+	out.ZonePriorities = *(*[]ZonePriority)(unsafe.Pointer(&in.ZonePriorities))
+	out.NetworkInterfaces = *(*[]NetworkInterfaceConfig)(unsafe.Pointer(&in.NetworkInterfaces))
+	out.KubeletConfigFromMachineClass = (*bool)(unsafe.Pointer(in.KubeletConfigFromMachineClass))
+	return nil
+}
+
+// Convert_ironcore_WorkerConfig_To_v1alpha1_WorkerConfig is an autogenerated conversion function.
+func Convert_ironcore_WorkerConfig_To_v1alpha1_WorkerConfig(in *ironcore.WorkerConfig, out *WorkerConfig, s conversion.Scope) error {
+	return autoConvert_ironcore_WorkerConfig_To_v1alpha1_WorkerConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_WorkerPoolKubeletConfig_To_ironcore_WorkerPoolKubeletConfig(in *WorkerPoolKubeletConfig, out *ironcore.WorkerPoolKubeletConfig, s conversion.Scope) error {
+	out.PoolName = in.PoolName
+	out.KubeReserved = *(*corev1.ResourceList)(unsafe.Pointer(&in.KubeReserved))
+	out.MaxPods = (*int32)(unsafe.Pointer(in.MaxPods))
+	return nil
+}
+
+// Convert_v1alpha1_WorkerPoolKubeletConfig_To_ironcore_WorkerPoolKubeletConfig is an autogenerated conversion function.
+func Convert_v1alpha1_WorkerPoolKubeletConfig_To_ironcore_WorkerPoolKubeletConfig(in *WorkerPoolKubeletConfig, out *ironcore.WorkerPoolKubeletConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_WorkerPoolKubeletConfig_To_ironcore_WorkerPoolKubeletConfig(in, out, s)
+}
+
+func autoConvert_ironcore_WorkerPoolKubeletConfig_To_v1alpha1_WorkerPoolKubeletConfig(in *ironcore.WorkerPoolKubeletConfig, out *WorkerPoolKubeletConfig, s conversion.Scope) error {
+	out.PoolName = in.PoolName
+	out.KubeReserved = *(*corev1.ResourceList)(unsafe.Pointer(&in.KubeReserved))
+	out.MaxPods = (*int32)(unsafe.Pointer(in.MaxPods))
+	return nil
+}
+
+// Convert_ironcore_WorkerPoolKubeletConfig_To_v1alpha1_WorkerPoolKubeletConfig is an autogenerated conversion function.
+func Convert_ironcore_WorkerPoolKubeletConfig_To_v1alpha1_WorkerPoolKubeletConfig(in *ironcore.WorkerPoolKubeletConfig, out *WorkerPoolKubeletConfig, s conversion.Scope) error {
+	return autoConvert_ironcore_WorkerPoolKubeletConfig_To_v1alpha1_WorkerPoolKubeletConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_WorkerStatus_To_ironcore_WorkerStatus(in *WorkerStatus, out *ironcore.WorkerStatus, s conversion.Scope) error {
 	out.MachineImages = *(*[]ironcore.MachineImage)(unsafe.Pointer(&in.MachineImages))
+	out.Machines = *(*[]ironcore.MachineState)(unsafe.Pointer(&in.Machines))
+	out.KubeletConfigs = *(*[]ironcore.WorkerPoolKubeletConfig)(unsafe.Pointer(&in.KubeletConfigs))
 	return nil
 }
 
@@ -419,6 +956,8 @@ func Convert_v1alpha1_WorkerStatus_To_ironcore_WorkerStatus(in *WorkerStatus, ou
 
 func autoConvert_ironcore_WorkerStatus_To_v1alpha1_WorkerStatus(in *ironcore.WorkerStatus, out *WorkerStatus, s conversion.Scope) error {
 	out.MachineImages = *(*[]MachineImage)(unsafe.Pointer(&in.MachineImages))
+	out.Machines = *(*[]MachineState)(unsafe.Pointer(&in.Machines))
+	out.KubeletConfigs = *(*[]WorkerPoolKubeletConfig)(unsafe.Pointer(&in.KubeletConfigs))
 	return nil
 }
 
@@ -426,3 +965,69 @@ func autoConvert_ironcore_WorkerStatus_To_v1alpha1_WorkerStatus(in *ironcore.Wor
 func Convert_ironcore_WorkerStatus_To_v1alpha1_WorkerStatus(in *ironcore.WorkerStatus, out *WorkerStatus, s conversion.Scope) error {
 	return autoConvert_ironcore_WorkerStatus_To_v1alpha1_WorkerStatus(in, out, s)
 }
+
+func autoConvert_v1alpha1_WorkloadIdentityConfig_To_ironcore_WorkloadIdentityConfig(in *WorkloadIdentityConfig, out *ironcore.WorkloadIdentityConfig, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	return nil
+}
+
+// Convert_v1alpha1_WorkloadIdentityConfig_To_ironcore_WorkloadIdentityConfig is an autogenerated conversion function.
+func Convert_v1alpha1_WorkloadIdentityConfig_To_ironcore_WorkloadIdentityConfig(in *WorkloadIdentityConfig, out *ironcore.WorkloadIdentityConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_WorkloadIdentityConfig_To_ironcore_WorkloadIdentityConfig(in, out, s)
+}
+
+func autoConvert_ironcore_WorkloadIdentityConfig_To_v1alpha1_WorkloadIdentityConfig(in *ironcore.WorkloadIdentityConfig, out *WorkloadIdentityConfig, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	return nil
+}
+
+// Convert_ironcore_WorkloadIdentityConfig_To_v1alpha1_WorkloadIdentityConfig is an autogenerated conversion function.
+func Convert_ironcore_WorkloadIdentityConfig_To_v1alpha1_WorkloadIdentityConfig(in *ironcore.WorkloadIdentityConfig, out *WorkloadIdentityConfig, s conversion.Scope) error {
+	return autoConvert_ironcore_WorkloadIdentityConfig_To_v1alpha1_WorkloadIdentityConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_ZoneConfig_To_ironcore_ZoneConfig(in *ZoneConfig, out *ironcore.ZoneConfig, s conversion.Scope) error {
+	out.Name = in.Name
+	out.VolumePoolName = (*string)(unsafe.Pointer(in.VolumePoolName))
+	out.MachinePoolSelector = *(*map[string]string)(unsafe.Pointer(&in.MachinePoolSelector))
+	return nil
+}
+
+// Convert_v1alpha1_ZoneConfig_To_ironcore_ZoneConfig is an autogenerated conversion function.
+func Convert_v1alpha1_ZoneConfig_To_ironcore_ZoneConfig(in *ZoneConfig, out *ironcore.ZoneConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ZoneConfig_To_ironcore_ZoneConfig(in, out, s)
+}
+
+func autoConvert_ironcore_ZoneConfig_To_v1alpha1_ZoneConfig(in *ironcore.ZoneConfig, out *ZoneConfig, s conversion.Scope) error {
+	out.Name = in.Name
+	out.VolumePoolName = (*string)(unsafe.Pointer(in.VolumePoolName))
+	out.MachinePoolSelector = *(*map[string]string)(unsafe.Pointer(&in.MachinePoolSelector))
+	return nil
+}
+
+// Convert_ironcore_ZoneConfig_To_v1alpha1_ZoneConfig is an autogenerated conversion function.
+func Convert_ironcore_ZoneConfig_To_v1alpha1_ZoneConfig(in *ironcore.ZoneConfig, out *ZoneConfig, s conversion.Scope) error {
+	return autoConvert_ironcore_ZoneConfig_To_v1alpha1_ZoneConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_ZonePriority_To_ironcore_ZonePriority(in *ZonePriority, out *ironcore.ZonePriority, s conversion.Scope) error {
+	out.Zone = in.Zone
+	out.Priority = in.Priority
+	return nil
+}
+
+// Convert_v1alpha1_ZonePriority_To_ironcore_ZonePriority is an autogenerated conversion function.
+func Convert_v1alpha1_ZonePriority_To_ironcore_ZonePriority(in *ZonePriority, out *ironcore.ZonePriority, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ZonePriority_To_ironcore_ZonePriority(in, out, s)
+}
+
+func autoConvert_ironcore_ZonePriority_To_v1alpha1_ZonePriority(in *ironcore.ZonePriority, out *ZonePriority, s conversion.Scope) error {
+	out.Zone = in.Zone
+	out.Priority = in.Priority
+	return nil
+}
+
+// Convert_ironcore_ZonePriority_To_v1alpha1_ZonePriority is an autogenerated conversion function.
+func Convert_ironcore_ZonePriority_To_v1alpha1_ZonePriority(in *ironcore.ZonePriority, out *ZonePriority, s conversion.Scope) error {
+	return autoConvert_ironcore_ZonePriority_To_v1alpha1_ZonePriority(in, out, s)
+}
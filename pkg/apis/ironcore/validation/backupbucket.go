@@ -8,6 +8,8 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	apisironcore "github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/apis/ironcore"
 )
 
 var (
@@ -31,3 +33,30 @@ func ValidateBackupBucketCredentialsRef(credentialsRef *corev1.ObjectReference,
 
 	return allErrs
 }
+
+// ValidateBackupBucketConfig validates a BackupBucketConfig object.
+func ValidateBackupBucketConfig(config *apisironcore.BackupBucketConfig, region string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if config.Replication == nil {
+		return allErrs
+	}
+
+	replicationPath := fldPath.Child("replication")
+	if len(config.Replication.Region) == 0 {
+		allErrs = append(allErrs, field.Required(replicationPath.Child("region"), "must provide the region of the secondary bucket"))
+	} else if config.Replication.Region == region {
+		allErrs = append(allErrs, field.Invalid(replicationPath.Child("region"), config.Replication.Region, "must differ from the region of the primary bucket"))
+	}
+	if len(config.Replication.SecretRef.Name) == 0 {
+		allErrs = append(allErrs, field.Required(replicationPath.Child("secretRef", "name"), "must provide the name of the secret"))
+	}
+	if len(config.Replication.SecretRef.Namespace) == 0 {
+		allErrs = append(allErrs, field.Required(replicationPath.Child("secretRef", "namespace"), "must provide the namespace of the secret"))
+	}
+	if config.Replication.BucketClassName != nil && len(*config.Replication.BucketClassName) == 0 {
+		allErrs = append(allErrs, field.Invalid(replicationPath.Child("bucketClassName"), *config.Replication.BucketClassName, "must not be empty if set"))
+	}
+
+	return allErrs
+}
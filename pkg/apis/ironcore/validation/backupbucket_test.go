@@ -10,6 +10,9 @@ import (
 	. "github.com/onsi/gomega/gstruct"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	apisironcore "github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/apis/ironcore"
 )
 
 var _ = Describe("BackupBucket", func() {
@@ -70,4 +73,64 @@ var _ = Describe("BackupBucket", func() {
 			Expect(errs).To(BeEmpty())
 		})
 	})
+
+	Describe("ValidateBackupBucketConfig", func() {
+		var (
+			fldPath *field.Path
+			config  *apisironcore.BackupBucketConfig
+		)
+
+		BeforeEach(func() {
+			fldPath = field.NewPath("spec", "providerConfig")
+			config = &apisironcore.BackupBucketConfig{
+				Replication: &apisironcore.BackupBucketReplication{
+					Region: "region-b",
+					SecretRef: corev1.SecretReference{
+						Name:      "backup-region-b",
+						Namespace: "garden",
+					},
+				},
+			}
+		})
+
+		It("should allow a config without replication", func() {
+			Expect(ValidateBackupBucketConfig(&apisironcore.BackupBucketConfig{}, "region-a", fldPath)).To(BeEmpty())
+		})
+
+		It("should allow a valid replication config", func() {
+			config.Replication.BucketClassName = ptr.To("fast")
+			Expect(ValidateBackupBucketConfig(config, "region-a", fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid replicating into the primary region", func() {
+			errs := ValidateBackupBucketConfig(config, "region-b", fldPath)
+			Expect(errs).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.providerConfig.replication.region"),
+			}))))
+		})
+
+		It("should require region, secretRef and a non-empty bucket class", func() {
+			config.Replication = &apisironcore.BackupBucketReplication{BucketClassName: ptr.To("")}
+			errs := ValidateBackupBucketConfig(config, "region-a", fldPath)
+			Expect(errs).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.providerConfig.replication.region"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.providerConfig.replication.secretRef.name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.providerConfig.replication.secretRef.namespace"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.providerConfig.replication.bucketClassName"),
+				})),
+			))
+		})
+	})
 })
@@ -4,22 +4,31 @@
 package validation
 
 import (
+	"bytes"
+	"crypto/x509"
+	"encoding/pem"
 	"fmt"
+	"net/url"
 	"slices"
 
 	gardenercore "github.com/gardener/gardener/pkg/apis/core"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/utils"
 	gutil "github.com/gardener/gardener/pkg/utils/gardener"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 
 	apisironcore "github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/apis/ironcore"
 )
 
-// ValidateCloudProfileConfig validates a CloudProfileConfig object.
-func ValidateCloudProfileConfig(cpConfig *apisironcore.CloudProfileConfig, machineImages []gardenercore.MachineImage, fldPath *field.Path) field.ErrorList {
+// ValidateCloudProfileConfig validates a CloudProfileConfig object against the machine images and regions of its
+// CloudProfile.
+func ValidateCloudProfileConfig(cpConfig *apisironcore.CloudProfileConfig, machineImages []gardenercore.MachineImage, regions []gardenercore.Region, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	machineImagesPath := fldPath.Child("machineImages")
 
@@ -30,15 +39,253 @@ func ValidateCloudProfileConfig(cpConfig *apisironcore.CloudProfileConfig, machi
 	}
 	allErrs = append(allErrs, validateProviderImagesMapping(cpConfig.MachineImages, machineImages, field.NewPath("spec").Child("machineImages"))...)
 
-	if cpConfig.StorageClasses.Default != nil {
-		for _, msg := range apivalidation.NameIsDNSLabel(cpConfig.StorageClasses.Default.Name, false) {
-			allErrs = append(allErrs, field.Invalid(fldPath.Child("storageClasses").Child("defaultStorageClasses").Child("name"), cpConfig.StorageClasses.Default.Name, msg))
+	allErrs = append(allErrs, ValidateStorageClasses(cpConfig.StorageClasses, fldPath.Child("storageClasses"))...)
+	allErrs = append(allErrs, ValidateRegionConfigs(cpConfig.RegionConfigs, fldPath.Child("regionConfigs"))...)
+	allErrs = append(allErrs, validateRegionConfigsMapping(cpConfig.RegionConfigs, regions, field.NewPath("spec").Child("regions"))...)
+
+	allErrs = append(allErrs, validateVolumeSnapshotClasses(cpConfig.VolumeSnapshotClasses, fldPath.Child("volumeSnapshotClasses"))...)
+
+	return allErrs
+}
+
+var (
+	supportedReclaimPolicies = sets.New(
+		string(corev1.PersistentVolumeReclaimDelete),
+		string(corev1.PersistentVolumeReclaimRetain),
+	)
+	supportedVolumeBindingModes = sets.New(
+		string(storagev1.VolumeBindingImmediate),
+		string(storagev1.VolumeBindingWaitForFirstConsumer),
+	)
+	// reservedStorageClassParameters are set by the extension itself and can't be configured as parameters.
+	reservedStorageClassParameters = sets.New("type", "encrypted")
+)
+
+// ValidateStorageClasses validates the default and the additional StorageClasses of a CloudProfileConfig.
+func ValidateStorageClasses(storageClasses apisironcore.StorageClasses, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if storageClasses.Default != nil {
+		allErrs = append(allErrs, validateStorageClass(storageClasses.Default, fldPath.Child("defaultStorageClasses"))...)
+	}
+	for i, sc := range storageClasses.Additional {
+		allErrs = append(allErrs, validateStorageClass(&sc, fldPath.Child("additionalStorageClasses").Index(i))...)
+	}
+
+	return allErrs
+}
+
+func validateStorageClass(sc *apisironcore.StorageClass, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for _, msg := range apivalidation.NameIsDNSLabel(sc.Name, false) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("name"), sc.Name, msg))
+	}
+	if sc.ReclaimPolicy != nil && !supportedReclaimPolicies.Has(string(*sc.ReclaimPolicy)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("reclaimPolicy"), *sc.ReclaimPolicy, sets.List(supportedReclaimPolicies)))
+	}
+	if sc.VolumeBindingMode != nil && !supportedVolumeBindingModes.Has(string(*sc.VolumeBindingMode)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("volumeBindingMode"), *sc.VolumeBindingMode, sets.List(supportedVolumeBindingModes)))
+	}
+	for i, option := range sc.MountOptions {
+		if len(option) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("mountOptions").Index(i), "mount option must not be empty"))
 		}
 	}
 
-	for i, sc := range cpConfig.StorageClasses.Additional {
-		for _, msg := range apivalidation.NameIsDNSLabel(sc.Name, false) {
-			allErrs = append(allErrs, field.Invalid(fldPath.Child("storageClasses").Child("additionalStorageClasses").Index(i).Child("name"), sc.Name, msg))
+	zones := sets.New[string]()
+	for i, zone := range sc.AllowedZones {
+		zonePath := fldPath.Child("allowedZones").Index(i)
+		if len(zone) == 0 {
+			allErrs = append(allErrs, field.Required(zonePath, "zone must not be empty"))
+			continue
+		}
+		if zones.Has(zone) {
+			allErrs = append(allErrs, field.Duplicate(zonePath, zone))
+		}
+		zones.Insert(zone)
+	}
+
+	for key := range sc.Parameters {
+		if reservedStorageClassParameters.Has(key) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("parameters").Key(key), "parameter is managed by the extension"))
+		}
+	}
+
+	return allErrs
+}
+
+var supportedProxySchemes = sets.New("http", "https", "socks5")
+
+// ValidateRegionConfigs validates the RegionConfigs of a CloudProfileConfig.
+func ValidateRegionConfigs(regionConfigs []apisironcore.RegionConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	names := sets.New[string]()
+
+	for i, regionConfig := range regionConfigs {
+		idxPath := fldPath.Index(i)
+		if len(regionConfig.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a region name"))
+		} else if names.Has(regionConfig.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), regionConfig.Name))
+		}
+		names.Insert(regionConfig.Name)
+
+		if len(regionConfig.Server) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("server"), "must provide a server"))
+		} else {
+			allErrs = append(allErrs, validateServer(regionConfig.Server, idxPath.Child("server"))...)
+		}
+
+		servers := sets.New(regionConfig.Server)
+		for j, server := range regionConfig.FailoverServers {
+			serverPath := idxPath.Child("failoverServers").Index(j)
+			if servers.Has(server) {
+				allErrs = append(allErrs, field.Duplicate(serverPath, server))
+				continue
+			}
+			servers.Insert(server)
+			allErrs = append(allErrs, validateServer(server, serverPath)...)
+		}
+
+		if regionConfig.ProxyURL != nil {
+			proxyPath := idxPath.Child("proxyURL")
+			if proxyURL, err := url.Parse(*regionConfig.ProxyURL); err != nil {
+				allErrs = append(allErrs, field.Invalid(proxyPath, *regionConfig.ProxyURL, err.Error()))
+			} else if !supportedProxySchemes.Has(proxyURL.Scheme) {
+				allErrs = append(allErrs, field.NotSupported(proxyPath.Child("scheme"), proxyURL.Scheme, sets.List(supportedProxySchemes)))
+			} else if len(proxyURL.Host) == 0 {
+				allErrs = append(allErrs, field.Invalid(proxyPath, *regionConfig.ProxyURL, "must contain a host"))
+			}
+		}
+
+		if regionConfig.TLSServerName != nil && len(*regionConfig.TLSServerName) == 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("tlsServerName"), *regionConfig.TLSServerName, "must not be empty"))
+		}
+
+		if len(regionConfig.CertificateAuthorityData) > 0 {
+			if err := validateCertificateAuthority(regionConfig.CertificateAuthorityData); err != nil {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("certificateAuthorityData"), "(redacted)", err.Error()))
+			}
+		}
+
+		allErrs = append(allErrs, validateZoneConfigs(regionConfig.Zones, idxPath.Child("zones"))...)
+	}
+
+	return allErrs
+}
+
+// validateServer checks that the given server is an HTTPS URL with a host.
+func validateServer(server string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return append(allErrs, field.Invalid(fldPath, server, err.Error()))
+	}
+	if serverURL.Scheme != "https" {
+		allErrs = append(allErrs, field.Invalid(fldPath, server, "must be an https URL"))
+	}
+	if len(serverURL.Host) == 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, server, "must contain a host"))
+	}
+
+	return allErrs
+}
+
+// validateCertificateAuthority checks that the given data consists of PEM encoded certificates only.
+func validateCertificateAuthority(data []byte) error {
+	rest := data
+	certificates := 0
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			return fmt.Errorf("unexpected PEM block of type %q", block.Type)
+		}
+		if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+			return fmt.Errorf("failed to parse certificate: %w", err)
+		}
+		certificates++
+	}
+	if certificates == 0 || len(bytes.TrimSpace(rest)) > 0 {
+		return fmt.Errorf("must contain PEM encoded certificates only")
+	}
+	return nil
+}
+
+// verify that for each cp region a region config exists
+func validateRegionConfigsMapping(regionConfigs []apisironcore.RegionConfig, regions []gardenercore.Region, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	names := sets.New[string]()
+	for _, regionConfig := range regionConfigs {
+		names.Insert(regionConfig.Name)
+	}
+	for i, region := range regions {
+		if !names.Has(region.Name) {
+			allErrs = append(allErrs, field.Required(fldPath.Index(i), fmt.Sprintf("must provide a region config for region %q", region.Name)))
+		}
+	}
+
+	return allErrs
+}
+
+func validateZoneConfigs(zones []apisironcore.ZoneConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	names := sets.New[string]()
+
+	for i, zone := range zones {
+		idxPath := fldPath.Index(i)
+		if len(zone.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a zone name"))
+		} else if names.Has(zone.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), zone.Name))
+		}
+		names.Insert(zone.Name)
+
+		if zone.VolumePoolName != nil {
+			for _, msg := range apivalidation.NameIsDNSSubdomain(*zone.VolumePoolName, false) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("volumePoolName"), *zone.VolumePoolName, msg))
+			}
+		}
+		allErrs = append(allErrs, metav1validation.ValidateLabels(zone.MachinePoolSelector, idxPath.Child("machinePoolSelector"))...)
+	}
+
+	return allErrs
+}
+
+var supportedVolumeSnapshotDeletionPolicies = sets.New(
+	string(apisironcore.VolumeSnapshotDeletionPolicyDelete),
+	string(apisironcore.VolumeSnapshotDeletionPolicyRetain),
+)
+
+func validateVolumeSnapshotClasses(classes []apisironcore.VolumeSnapshotClass, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	names := sets.New[string]()
+	defaults := 0
+
+	for i, class := range classes {
+		idxPath := fldPath.Index(i)
+		for _, msg := range apivalidation.NameIsDNSSubdomain(class.Name, false) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), class.Name, msg))
+		}
+		if names.Has(class.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), class.Name))
+		}
+		names.Insert(class.Name)
+
+		if ptr.Deref(class.Default, false) {
+			defaults++
+			if defaults > 1 {
+				allErrs = append(allErrs, field.Forbidden(idxPath.Child("default"), "only one volumeSnapshotClass can be marked as default"))
+			}
+		}
+		if class.DeletionPolicy != nil && !supportedVolumeSnapshotDeletionPolicies.Has(string(*class.DeletionPolicy)) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("deletionPolicy"), *class.DeletionPolicy, sets.List(supportedVolumeSnapshotDeletionPolicies)))
 		}
 	}
 
@@ -4,11 +4,21 @@
 package validation
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"time"
+
 	"github.com/gardener/gardener/pkg/apis/core"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
 	"github.com/onsi/gomega/types"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 
@@ -31,6 +41,7 @@ var _ = Describe("CloudProfileConfig validation", func() {
 		var (
 			cloudProfileConfig  *apisironcore.CloudProfileConfig
 			machineImages       []core.MachineImage
+			regions             []core.Region
 			nilPath             *field.Path
 			machineImageName    string
 			machineImageVersion string
@@ -69,6 +80,7 @@ var _ = Describe("CloudProfileConfig validation", func() {
 					},
 				},
 			}
+			regions = nil
 			machineImages = []core.MachineImage{
 				{
 					Name: machineImageName,
@@ -83,7 +95,7 @@ var _ = Describe("CloudProfileConfig validation", func() {
 
 		Describe("machine image validation", func() {
 			It("should pass validation", func() {
-				errorList := ValidateCloudProfileConfig(cloudProfileConfig, machineImages, nilPath)
+				errorList := ValidateCloudProfileConfig(cloudProfileConfig, machineImages, regions, nilPath)
 				Expect(errorList).To(BeEmpty())
 			})
 
@@ -92,7 +104,7 @@ var _ = Describe("CloudProfileConfig validation", func() {
 					Name:     "suse",
 					Versions: nil,
 				})
-				errorList := ValidateCloudProfileConfig(cloudProfileConfig, machineImages, nilPath)
+				errorList := ValidateCloudProfileConfig(cloudProfileConfig, machineImages, regions, nilPath)
 				Expect(errorList).To(BeEmpty())
 			})
 
@@ -107,7 +119,7 @@ var _ = Describe("CloudProfileConfig validation", func() {
 						},
 					},
 				})
-				errorList := ValidateCloudProfileConfig(cloudProfileConfig, machineImages, nilPath)
+				errorList := ValidateCloudProfileConfig(cloudProfileConfig, machineImages, regions, nilPath)
 				Expect(errorList).To(ConsistOf(
 					PointTo(MatchFields(IgnoreExtras, Fields{
 						"Type":   Equal(field.ErrorTypeRequired),
@@ -122,7 +134,7 @@ var _ = Describe("CloudProfileConfig validation", func() {
 				cloudProfileConfig.MachineImages[0].Versions[0].Architecture = ptr.To[string]("foo")
 				machineImages[0].Versions = append(machineImages[0].Versions, core.MachineImageVersion{ExpirableVersion: core.ExpirableVersion{Version: "2.0.0"}, Architectures: []string{"amd64"}})
 
-				errorList := ValidateCloudProfileConfig(cloudProfileConfig, machineImages, nilPath)
+				errorList := ValidateCloudProfileConfig(cloudProfileConfig, machineImages, regions, nilPath)
 
 				Expect(errorList).To(ConsistOf(
 					PointTo(MatchFields(IgnoreExtras, Fields{
@@ -146,7 +158,7 @@ var _ = Describe("CloudProfileConfig validation", func() {
 
 		DescribeTable("ValidateCloudProfileConfig StorageClass name",
 			func(cpConfig *apisironcore.CloudProfileConfig, machineImages []core.MachineImage, fldPath *field.Path, match types.GomegaMatcher) {
-				errList := ValidateCloudProfileConfig(cpConfig, machineImages, fldPath)
+				errList := ValidateCloudProfileConfig(cpConfig, machineImages, nil, fldPath)
 				Expect(errList).To(match)
 			},
 			Entry("invalid storageClass name in default StorageClass",
@@ -177,7 +189,235 @@ var _ = Describe("CloudProfileConfig validation", func() {
 				nilPath,
 				ContainElement(InvalidField("storageClasses.additionalStorageClasses[0].name")),
 			),
+			Entry("unsupported reclaim policy in default StorageClass",
+				&apisironcore.CloudProfileConfig{
+					StorageClasses: apisironcore.StorageClasses{
+						Default: &apisironcore.StorageClass{
+							Name:          "foo",
+							Type:          "defaultType",
+							ReclaimPolicy: ptr.To(corev1.PersistentVolumeReclaimRecycle),
+						},
+					},
+				},
+				machineImages,
+				nilPath,
+				ContainElement(SimpleMatchField(field.ErrorTypeNotSupported, "storageClasses.defaultStorageClasses.reclaimPolicy")),
+			),
+			Entry("reserved parameter in additional storageClasses",
+				&apisironcore.CloudProfileConfig{
+					StorageClasses: apisironcore.StorageClasses{
+						Additional: []apisironcore.StorageClass{
+							{
+								Name:       "foo",
+								Type:       "defaultType",
+								Parameters: map[string]string{"encrypted": "false"},
+							},
+						},
+					},
+				},
+				machineImages,
+				nilPath,
+				ContainElement(SimpleMatchField(field.ErrorTypeForbidden, "storageClasses.additionalStorageClasses[0].parameters[encrypted]")),
+			),
 		)
 
+		Describe("region config validation", func() {
+			var caData []byte
+
+			BeforeEach(func() {
+				caData = generateCACertificate()
+				regions = []core.Region{{Name: "region-a"}, {Name: "region-b"}}
+				cloudProfileConfig.RegionConfigs = []apisironcore.RegionConfig{
+					{
+						Name:                     "region-a",
+						Server:                   "https://api.region-a.example.com",
+						CertificateAuthorityData: caData,
+						FailoverServers:          []string{"https://api-2.region-a.example.com"},
+						ProxyURL:                 ptr.To("http://proxy.example.com:3128"),
+						TLSServerName:            ptr.To("api.region-a.example.com"),
+					},
+					{
+						Name:   "region-b",
+						Server: "https://api.region-b.example.com:6443",
+					},
+				}
+			})
+
+			It("should pass validation for valid region configs", func() {
+				Expect(ValidateCloudProfileConfig(cloudProfileConfig, machineImages, regions, nilPath)).To(BeEmpty())
+			})
+
+			It("should forbid empty and duplicate region names", func() {
+				cloudProfileConfig.RegionConfigs[1].Name = "region-a"
+				cloudProfileConfig.RegionConfigs = append(cloudProfileConfig.RegionConfigs, apisironcore.RegionConfig{Server: "https://localhost"})
+				Expect(ValidateCloudProfileConfig(cloudProfileConfig, machineImages, regions, nilPath)).To(ConsistOf(
+					SimpleMatchField(field.ErrorTypeDuplicate, "regionConfigs[1].name"),
+					SimpleMatchField(field.ErrorTypeRequired, "regionConfigs[2].name"),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeRequired),
+						"Field":  Equal("spec.regions[1]"),
+						"Detail": Equal("must provide a region config for region \"region-b\""),
+					})),
+				))
+			})
+
+			It("should forbid missing, non-HTTPS and unparsable servers", func() {
+				cloudProfileConfig.RegionConfigs[0].Server = "http://api.region-a.example.com"
+				cloudProfileConfig.RegionConfigs[0].FailoverServers = []string{"https://api.region-a.example.com:%zz", "https://", "http://api.region-a.example.com"}
+				cloudProfileConfig.RegionConfigs[1].Server = ""
+				Expect(ValidateCloudProfileConfig(cloudProfileConfig, machineImages, regions, nilPath)).To(ConsistOf(
+					InvalidField("regionConfigs[0].server"),
+					InvalidField("regionConfigs[0].failoverServers[0]"),
+					InvalidField("regionConfigs[0].failoverServers[1]"),
+					SimpleMatchField(field.ErrorTypeDuplicate, "regionConfigs[0].failoverServers[2]"),
+					SimpleMatchField(field.ErrorTypeRequired, "regionConfigs[1].server"),
+				))
+			})
+
+			It("should forbid invalid proxy URLs and empty TLS server names", func() {
+				cloudProfileConfig.RegionConfigs[0].ProxyURL = ptr.To("ftp://proxy.example.com")
+				cloudProfileConfig.RegionConfigs[0].TLSServerName = ptr.To("")
+				cloudProfileConfig.RegionConfigs[1].ProxyURL = ptr.To("http://")
+				Expect(ValidateCloudProfileConfig(cloudProfileConfig, machineImages, regions, nilPath)).To(ConsistOf(
+					SimpleMatchField(field.ErrorTypeNotSupported, "regionConfigs[0].proxyURL.scheme"),
+					InvalidField("regionConfigs[0].tlsServerName"),
+					InvalidField("regionConfigs[1].proxyURL"),
+				))
+			})
+
+			It("should forbid certificate authority data which can't be decoded", func() {
+				cloudProfileConfig.RegionConfigs[0].CertificateAuthorityData = []byte("foo")
+				cloudProfileConfig.RegionConfigs[1].CertificateAuthorityData = append(caData, []byte("-----BEGIN CERTIFICATE-----\nZm9v\n-----END CERTIFICATE-----\n")...)
+				Expect(ValidateCloudProfileConfig(cloudProfileConfig, machineImages, regions, nilPath)).To(ConsistOf(
+					InvalidField("regionConfigs[0].certificateAuthorityData"),
+					InvalidField("regionConfigs[1].certificateAuthorityData"),
+				))
+			})
+
+			It("should require a region config for every region of the cloud profile", func() {
+				regions = append(regions, core.Region{Name: "region-c"})
+				Expect(ValidateCloudProfileConfig(cloudProfileConfig, machineImages, regions, nilPath)).To(ConsistOf(
+					SimpleMatchField(field.ErrorTypeRequired, "spec.regions[2]"),
+				))
+			})
+		})
+
+		Describe("region zone validation", func() {
+			It("should pass validation for valid zones", func() {
+				cloudProfileConfig.RegionConfigs = []apisironcore.RegionConfig{
+					{
+						Name:   "region",
+						Server: "https://localhost",
+						Zones: []apisironcore.ZoneConfig{
+							{Name: "zone-a", VolumePoolName: ptr.To("pool-a"), MachinePoolSelector: map[string]string{"topology.ironcore.dev/zone": "zone-a"}},
+							{Name: "zone-b"},
+						},
+					},
+				}
+				Expect(ValidateCloudProfileConfig(cloudProfileConfig, machineImages, regions, nilPath)).To(BeEmpty())
+			})
+
+			It("should forbid empty, duplicate zones and invalid volume pool names", func() {
+				cloudProfileConfig.RegionConfigs = []apisironcore.RegionConfig{
+					{
+						Name:   "region",
+						Server: "https://localhost",
+						Zones: []apisironcore.ZoneConfig{
+							{Name: "zone-a"},
+							{Name: "zone-a"},
+							{Name: "", VolumePoolName: ptr.To("Pool_A")},
+						},
+					},
+				}
+				Expect(ValidateCloudProfileConfig(cloudProfileConfig, machineImages, regions, nilPath)).To(ConsistOf(
+					SimpleMatchField(field.ErrorTypeDuplicate, "regionConfigs[0].zones[1].name"),
+					SimpleMatchField(field.ErrorTypeRequired, "regionConfigs[0].zones[2].name"),
+					InvalidField("regionConfigs[0].zones[2].volumePoolName"),
+				))
+			})
+
+			It("should forbid invalid machine pool selectors", func() {
+				cloudProfileConfig.RegionConfigs = []apisironcore.RegionConfig{
+					{
+						Name:   "region",
+						Server: "https://localhost",
+						Zones: []apisironcore.ZoneConfig{
+							{Name: "zone-a", MachinePoolSelector: map[string]string{"invalid key": "zone-a"}},
+							{Name: "zone-b", MachinePoolSelector: map[string]string{"zone": "invalid value!"}},
+						},
+					},
+				}
+				Expect(ValidateCloudProfileConfig(cloudProfileConfig, machineImages, regions, nilPath)).To(ConsistOf(
+					InvalidField("regionConfigs[0].zones[0].machinePoolSelector"),
+					InvalidField("regionConfigs[0].zones[1].machinePoolSelector"),
+				))
+			})
+		})
+
+		Describe("volume snapshot class validation", func() {
+			It("should pass validation for valid volumeSnapshotClasses", func() {
+				cloudProfileConfig.VolumeSnapshotClasses = []apisironcore.VolumeSnapshotClass{
+					{
+						Name:           "default",
+						Default:        ptr.To(true),
+						DeletionPolicy: ptr.To(apisironcore.VolumeSnapshotDeletionPolicyDelete),
+					},
+					{
+						Name:           "retain",
+						DeletionPolicy: ptr.To(apisironcore.VolumeSnapshotDeletionPolicyRetain),
+						Parameters:     map[string]string{"foo": "bar"},
+					},
+				}
+				Expect(ValidateCloudProfileConfig(cloudProfileConfig, machineImages, regions, nilPath)).To(BeEmpty())
+			})
+
+			It("should forbid invalid and duplicate names", func() {
+				cloudProfileConfig.VolumeSnapshotClasses = []apisironcore.VolumeSnapshotClass{
+					{Name: "foo"},
+					{Name: "foo"},
+					{Name: "Foo*"},
+				}
+				Expect(ValidateCloudProfileConfig(cloudProfileConfig, machineImages, regions, nilPath)).To(ConsistOf(
+					SimpleMatchField(field.ErrorTypeDuplicate, "volumeSnapshotClasses[1].name"),
+					InvalidField("volumeSnapshotClasses[2].name"),
+				))
+			})
+
+			It("should forbid more than one default volumeSnapshotClass", func() {
+				cloudProfileConfig.VolumeSnapshotClasses = []apisironcore.VolumeSnapshotClass{
+					{Name: "foo", Default: ptr.To(true)},
+					{Name: "bar", Default: ptr.To(true)},
+				}
+				Expect(ValidateCloudProfileConfig(cloudProfileConfig, machineImages, regions, nilPath)).To(ConsistOf(
+					SimpleMatchField(field.ErrorTypeForbidden, "volumeSnapshotClasses[1].default"),
+				))
+			})
+
+			It("should forbid unsupported deletion policies", func() {
+				cloudProfileConfig.VolumeSnapshotClasses = []apisironcore.VolumeSnapshotClass{
+					{Name: "foo", DeletionPolicy: ptr.To(apisironcore.VolumeSnapshotDeletionPolicy("Keep"))},
+				}
+				Expect(ValidateCloudProfileConfig(cloudProfileConfig, machineImages, regions, nilPath)).To(ConsistOf(
+					SimpleMatchField(field.ErrorTypeNotSupported, "volumeSnapshotClasses[0].deletionPolicy"),
+				))
+			})
+		})
 	})
 })
+
+func generateCACertificate() []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).NotTo(HaveOccurred())
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "ca"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	Expect(err).NotTo(HaveOccurred())
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
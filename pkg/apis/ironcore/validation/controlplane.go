@@ -4,18 +4,114 @@
 package validation
 
 import (
+	"net"
+	"time"
+
 	featurevalidation "github.com/gardener/gardener/pkg/utils/validation/features"
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	apisironcore "github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/apis/ironcore"
 )
 
+// minRouteReconciliationPeriod is the lower bound for the reconciliation period of the route controller.
+const minRouteReconciliationPeriod = 10 * time.Second
+
+var (
+	supportedLoadBalancerTypes = sets.New(
+		string(apisironcore.LoadBalancerTypePublic),
+		string(apisironcore.LoadBalancerTypeInternal),
+	)
+	supportedSourceRangeEnforcementModes = sets.New(
+		string(apisironcore.SourceRangeEnforcementModeEnforce),
+		string(apisironcore.SourceRangeEnforcementModeIgnore),
+	)
+	supportedPodPrefixModes = sets.New(
+		string(apisironcore.PodPrefixModeRouted),
+		string(apisironcore.PodPrefixModeAlias),
+	)
+)
+
 // ValidateControlPlaneConfig validates a ControlPlaneConfig object.
 func ValidateControlPlaneConfig(controlPlaneConfig *apisironcore.ControlPlaneConfig, version string, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
-	if controlPlaneConfig.CloudControllerManager != nil {
-		allErrs = append(allErrs, featurevalidation.ValidateFeatureGates(controlPlaneConfig.CloudControllerManager.FeatureGates, version, fldPath.Child("cloudControllerManager", "featureGates"))...)
+	if ccm := controlPlaneConfig.CloudControllerManager; ccm != nil {
+		ccmPath := fldPath.Child("cloudControllerManager")
+		allErrs = append(allErrs, featurevalidation.ValidateFeatureGates(ccm.FeatureGates, version, ccmPath.Child("featureGates"))...)
+		if ccm.LoadBalancer != nil {
+			allErrs = append(allErrs, validateLoadBalancerConfig(ccm.LoadBalancer, ccmPath.Child("loadBalancer"))...)
+		}
+		if ccm.RouteController != nil {
+			allErrs = append(allErrs, validateRouteControllerConfig(ccm.RouteController, ccmPath.Child("routeController"))...)
+		}
+	}
+
+	if storage := controlPlaneConfig.Storage; storage != nil {
+		allErrs = append(allErrs, validateShootStorageClasses(storage.StorageClasses, fldPath.Child("storage", "storageClasses"))...)
+	}
+
+	return allErrs
+}
+
+func validateLoadBalancerConfig(lb *apisironcore.LoadBalancerConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if lb.DefaultType != nil && !supportedLoadBalancerTypes.Has(string(*lb.DefaultType)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("defaultType"), *lb.DefaultType, sets.List(supportedLoadBalancerTypes)))
+	}
+	if lb.SourceRangeEnforcement != nil && !supportedSourceRangeEnforcementModes.Has(string(*lb.SourceRangeEnforcement)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("sourceRangeEnforcement"), *lb.SourceRangeEnforcement, sets.List(supportedSourceRangeEnforcementModes)))
+	}
+
+	if vip := lb.VirtualIP; vip != nil {
+		vipPath := fldPath.Child("virtualIP")
+		if vip.Prefix != nil && vip.PoolRef != nil {
+			allErrs = append(allErrs, field.Forbidden(vipPath, "only one of prefix and poolRef may be set"))
+		}
+		if vip.Prefix != nil {
+			if _, _, err := net.ParseCIDR(*vip.Prefix); err != nil {
+				allErrs = append(allErrs, field.Invalid(vipPath.Child("prefix"), *vip.Prefix, "must be a valid CIDR"))
+			}
+		}
+		if vip.PoolRef != nil {
+			for _, msg := range apivalidation.NameIsDNSLabel(vip.PoolRef.Name, false) {
+				allErrs = append(allErrs, field.Invalid(vipPath.Child("poolRef", "name"), vip.PoolRef.Name, msg))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+func validateRouteControllerConfig(rc *apisironcore.RouteControllerConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if rc.ReconciliationPeriod != nil && rc.ReconciliationPeriod.Duration < minRouteReconciliationPeriod {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("reconciliationPeriod"), rc.ReconciliationPeriod.Duration.String(), "must be at least "+minRouteReconciliationPeriod.String()))
+	}
+	if rc.PodPrefixMode != nil && !supportedPodPrefixModes.Has(string(*rc.PodPrefixMode)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("podPrefixMode"), *rc.PodPrefixMode, sets.List(supportedPodPrefixModes)))
+	}
+
+	return allErrs
+}
+
+func validateShootStorageClasses(storageClasses []apisironcore.StorageClass, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	names := sets.New[string]()
+
+	for i, sc := range storageClasses {
+		idxPath := fldPath.Index(i)
+		allErrs = append(allErrs, validateStorageClass(&sc, idxPath)...)
+		if len(sc.Type) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("type"), "must reference a VolumeClass"))
+		}
+		if names.Has(sc.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), sc.Name))
+		}
+		names.Insert(sc.Name)
 	}
 
 	return allErrs
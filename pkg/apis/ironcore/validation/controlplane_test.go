@@ -4,10 +4,16 @@
 package validation
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
 	apisironcore "github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/apis/ironcore"
 )
@@ -51,6 +57,150 @@ var _ = Describe("ControlPlaneConfig validation", func() {
 		})
 	})
 
+	Describe("#ValidateControlPlaneConfig load balancer and route controller", func() {
+		BeforeEach(func() {
+			controlPlane.CloudControllerManager = &apisironcore.CloudControllerManagerConfig{
+				LoadBalancer: &apisironcore.LoadBalancerConfig{
+					DefaultType:            ptr.To(apisironcore.LoadBalancerTypeInternal),
+					SourceRangeEnforcement: ptr.To(apisironcore.SourceRangeEnforcementModeIgnore),
+					VirtualIP: &apisironcore.VirtualIPConfig{
+						Prefix: ptr.To("10.100.0.0/24"),
+					},
+				},
+				RouteController: &apisironcore.RouteControllerConfig{
+					ReconciliationPeriod: &metav1.Duration{Duration: time.Minute},
+				},
+			}
+		})
+
+		It("should return no errors for a valid configuration", func() {
+			Expect(ValidateControlPlaneConfig(controlPlane, "1.30.0", fldPath)).To(BeEmpty())
+		})
+
+		It("should fail with unsupported load balancer type and source range enforcement mode", func() {
+			controlPlane.CloudControllerManager.LoadBalancer.DefaultType = ptr.To(apisironcore.LoadBalancerType("External"))
+			controlPlane.CloudControllerManager.LoadBalancer.SourceRangeEnforcement = ptr.To(apisironcore.SourceRangeEnforcementMode("Sometimes"))
+
+			Expect(ValidateControlPlaneConfig(controlPlane, "1.30.0", fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("cloudControllerManager.loadBalancer.defaultType"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("cloudControllerManager.loadBalancer.sourceRangeEnforcement"),
+				})),
+			))
+		})
+
+		It("should fail with an invalid virtual IP prefix", func() {
+			controlPlane.CloudControllerManager.LoadBalancer.VirtualIP.Prefix = ptr.To("10.100.0.0")
+
+			Expect(ValidateControlPlaneConfig(controlPlane, "1.30.0", fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("cloudControllerManager.loadBalancer.virtualIP.prefix"),
+				})),
+			))
+		})
+
+		It("should forbid setting a virtual IP prefix and pool", func() {
+			controlPlane.CloudControllerManager.LoadBalancer.VirtualIP.PoolRef = &corev1.LocalObjectReference{Name: "vip-pool"}
+
+			Expect(ValidateControlPlaneConfig(controlPlane, "1.30.0", fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("cloudControllerManager.loadBalancer.virtualIP"),
+				})),
+			))
+		})
+
+		It("should fail with an invalid virtual IP pool name", func() {
+			controlPlane.CloudControllerManager.LoadBalancer.VirtualIP = &apisironcore.VirtualIPConfig{
+				PoolRef: &corev1.LocalObjectReference{Name: "vip%pool"},
+			}
+
+			Expect(ValidateControlPlaneConfig(controlPlane, "1.30.0", fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("cloudControllerManager.loadBalancer.virtualIP.poolRef.name"),
+				})),
+			))
+		})
+
+		It("should fail with a too short route reconciliation period", func() {
+			controlPlane.CloudControllerManager.RouteController.ReconciliationPeriod = &metav1.Duration{Duration: time.Second}
+
+			Expect(ValidateControlPlaneConfig(controlPlane, "1.30.0", fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("cloudControllerManager.routeController.reconciliationPeriod"),
+				})),
+			))
+		})
+
+		It("should fail with an unsupported pod prefix mode", func() {
+			controlPlane.CloudControllerManager.RouteController.PodPrefixMode = ptr.To(apisironcore.PodPrefixMode("Tunnel"))
+
+			Expect(ValidateControlPlaneConfig(controlPlane, "1.30.0", fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("cloudControllerManager.routeController.podPrefixMode"),
+				})),
+			))
+		})
+	})
+
+	Describe("#ValidateControlPlaneConfig storage classes", func() {
+		It("should return no errors for valid storage classes", func() {
+			controlPlane.Storage = &apisironcore.Storage{
+				StorageClasses: []apisironcore.StorageClass{
+					{
+						Name:              "fast",
+						Type:              "fast",
+						ReclaimPolicy:     ptr.To(corev1.PersistentVolumeReclaimRetain),
+						VolumeBindingMode: ptr.To(storagev1.VolumeBindingImmediate),
+						MountOptions:      []string{"noatime"},
+						Encrypted:         ptr.To(true),
+						AllowedZones:      []string{"zone-a", "zone-b"},
+						Parameters:        map[string]string{"fsType": "xfs"},
+					},
+				},
+			}
+
+			Expect(ValidateControlPlaneConfig(controlPlane, "1.30.0", fldPath)).To(BeEmpty())
+		})
+
+		It("should fail with invalid storage classes", func() {
+			controlPlane.Storage = &apisironcore.Storage{
+				StorageClasses: []apisironcore.StorageClass{
+					{
+						Name:              "fast",
+						ReclaimPolicy:     ptr.To(corev1.PersistentVolumeReclaimRecycle),
+						VolumeBindingMode: ptr.To(storagev1.VolumeBindingMode("Later")),
+						MountOptions:      []string{""},
+						AllowedZones:      []string{"zone-a", "zone-a"},
+						Parameters:        map[string]string{"type": "slow"},
+					},
+					{
+						Name: "fast",
+						Type: "fast",
+					},
+				},
+			}
+
+			Expect(ValidateControlPlaneConfig(controlPlane, "1.30.0", fldPath)).To(ConsistOf(
+				SimpleMatchField(field.ErrorTypeNotSupported, "storage.storageClasses[0].reclaimPolicy"),
+				SimpleMatchField(field.ErrorTypeNotSupported, "storage.storageClasses[0].volumeBindingMode"),
+				SimpleMatchField(field.ErrorTypeRequired, "storage.storageClasses[0].mountOptions[0]"),
+				SimpleMatchField(field.ErrorTypeDuplicate, "storage.storageClasses[0].allowedZones[1]"),
+				SimpleMatchField(field.ErrorTypeForbidden, "storage.storageClasses[0].parameters[type]"),
+				SimpleMatchField(field.ErrorTypeRequired, "storage.storageClasses[0].type"),
+				SimpleMatchField(field.ErrorTypeDuplicate, "storage.storageClasses[1].name"),
+			))
+		})
+	})
+
 	Describe("#ValidateControlPlaneConfigUpdate", func() {
 		It("should return no errors for an unchanged config", func() {
 			Expect(ValidateControlPlaneConfigUpdate(controlPlane, controlPlane, fldPath)).To(BeEmpty())
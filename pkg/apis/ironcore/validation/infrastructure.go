@@ -35,7 +35,11 @@ func ValidateInfrastructureConfigUpdate(oldConfig, newConfig *apisironcore.Infra
 	var (
 		allErrs = field.ErrorList{}
 	)
-	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newConfig.NetworkRef, oldConfig.NetworkRef, fldPath.Child("networkRef"))...)
+	if oldConfig.NetworkRef == nil && newConfig.NetworkRef != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("networkRef"), "a network reference can't be added after the shoot was created"))
+	} else {
+		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newConfig.NetworkRef, oldConfig.NetworkRef, fldPath.Child("networkRef"))...)
+	}
 
 	return allErrs
 }
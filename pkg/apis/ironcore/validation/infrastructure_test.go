@@ -53,6 +53,30 @@ var _ = Describe("InfrastructureConfig validation", func() {
 		It("should return no errors for an unchanged config", func() {
 			Expect(ValidateInfrastructureConfigUpdate(infra, infra, fldPath)).To(BeEmpty())
 		})
+
+		It("should forbid adding a network reference", func() {
+			oldInfra := infra.DeepCopy()
+			oldInfra.NetworkRef = nil
+
+			Expect(ValidateInfrastructureConfigUpdate(oldInfra, infra, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networkRef"),
+				})),
+			))
+		})
+
+		It("should forbid changing the network reference", func() {
+			oldInfra := infra.DeepCopy()
+			oldInfra.NetworkRef.Name = "other-network"
+
+			Expect(ValidateInfrastructureConfigUpdate(oldInfra, infra, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networkRef"),
+				})),
+			))
+		})
 	})
 
 	Describe("#ValidateInfrastructureConfigNATPorts", func() {
@@ -4,30 +4,107 @@
 package validation
 
 import (
+	"crypto/tls"
 	"fmt"
+	"net/url"
 
 	corev1 "k8s.io/api/core/v1"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 
 	"github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/ironcore"
 )
 
-// ValidateCloudProviderSecret checks whether the given secret contains a valid ironcore service account.
+// ValidateCloudProviderSecret checks whether the given secret contains valid ironcore credentials. The credentials are
+// either a token with a username, a client certificate with its key or a kubeconfig.
 func ValidateCloudProviderSecret(secret *corev1.Secret) error {
-	if _, ok := secret.Data[ironcore.TokenFieldName]; !ok {
-		return fmt.Errorf("missing field: %s in cloud provider secret", ironcore.TokenFieldName)
+	var credentials []string
+	for _, key := range []string{ironcore.TokenFieldName, ironcore.ClientCertificateFieldName, ironcore.KubeConfigFieldName} {
+		if _, ok := secret.Data[key]; ok {
+			credentials = append(credentials, key)
+		}
 	}
+	switch len(credentials) {
+	case 0:
+		return fmt.Errorf("missing credentials in cloud provider secret: one of %s, %s or %s must be set",
+			ironcore.TokenFieldName, ironcore.ClientCertificateFieldName, ironcore.KubeConfigFieldName)
+	case 1:
+	default:
+		return fmt.Errorf("only one of %v may be set in cloud provider secret", credentials)
+	}
+
 	namespace, ok := secret.Data[ironcore.NamespaceFieldName]
 	if !ok {
 		return fmt.Errorf("missing field: %s in cloud provider secret", ironcore.NamespaceFieldName)
 	}
-	if _, ok := secret.Data[ironcore.UsernameFieldName]; !ok {
-		return fmt.Errorf("missing field: %s in cloud provider secret", ironcore.UsernameFieldName)
-	}
 	errs := apivalidation.ValidateNamespaceName(string(namespace), false)
 	if len(errs) > 0 {
 		return fmt.Errorf("invalid field: %s in cloud provider secret", ironcore.NamespaceFieldName)
 	}
 
+	switch credentials[0] {
+	case ironcore.TokenFieldName:
+		if _, ok := secret.Data[ironcore.UsernameFieldName]; !ok {
+			return fmt.Errorf("missing field: %s in cloud provider secret", ironcore.UsernameFieldName)
+		}
+	case ironcore.ClientCertificateFieldName:
+		key, ok := secret.Data[ironcore.ClientKeyFieldName]
+		if !ok {
+			return fmt.Errorf("missing field: %s in cloud provider secret", ironcore.ClientKeyFieldName)
+		}
+		if _, err := tls.X509KeyPair(secret.Data[ironcore.ClientCertificateFieldName], key); err != nil {
+			return fmt.Errorf("invalid field: %s in cloud provider secret: %w", ironcore.ClientCertificateFieldName, err)
+		}
+	case ironcore.KubeConfigFieldName:
+		if _, err := ValidateKubeconfig(secret.Data[ironcore.KubeConfigFieldName]); err != nil {
+			return fmt.Errorf("invalid field: %s in cloud provider secret: %w", ironcore.KubeConfigFieldName, err)
+		}
+	}
+
 	return nil
 }
+
+// ValidateKubeconfig checks that the given kubeconfig only authenticates with inline credentials against an HTTPS
+// server, and returns the cluster and user of its current context. Credential plugins and references to local
+// files are rejected, since they would be executed or read on the seed.
+func ValidateKubeconfig(data []byte) (*clientcmdapi.Config, error) {
+	config, err := clientcmd.Load(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse kubeconfig: %w", err)
+	}
+
+	kubeContext, ok := config.Contexts[config.CurrentContext]
+	if !ok {
+		return nil, fmt.Errorf("current context %q not found", config.CurrentContext)
+	}
+	cluster, ok := config.Clusters[kubeContext.Cluster]
+	if !ok {
+		return nil, fmt.Errorf("cluster %q of current context not found", kubeContext.Cluster)
+	}
+	authInfo, ok := config.AuthInfos[kubeContext.AuthInfo]
+	if !ok {
+		return nil, fmt.Errorf("user %q of current context not found", kubeContext.AuthInfo)
+	}
+
+	server, err := url.Parse(cluster.Server)
+	if err != nil || server.Scheme != "https" || server.Host == "" {
+		return nil, fmt.Errorf("server %q of cluster %q must be a valid https URL", cluster.Server, kubeContext.Cluster)
+	}
+	if len(cluster.CertificateAuthority) > 0 {
+		return nil, fmt.Errorf("cluster %q must not reference a certificate authority file", kubeContext.Cluster)
+	}
+
+	switch {
+	case authInfo.Exec != nil:
+		return nil, fmt.Errorf("user %q must not use a credential plugin", kubeContext.AuthInfo)
+	case authInfo.AuthProvider != nil:
+		return nil, fmt.Errorf("user %q must not use an auth provider", kubeContext.AuthInfo)
+	case len(authInfo.TokenFile) > 0, len(authInfo.ClientCertificate) > 0, len(authInfo.ClientKey) > 0:
+		return nil, fmt.Errorf("user %q must not reference files", kubeContext.AuthInfo)
+	case len(authInfo.Token) == 0 && (len(authInfo.ClientCertificateData) == 0 || len(authInfo.ClientKeyData) == 0):
+		return nil, fmt.Errorf("user %q must either have a token or a client certificate and key", kubeContext.AuthInfo)
+	}
+
+	return config, nil
+}
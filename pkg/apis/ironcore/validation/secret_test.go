@@ -4,10 +4,21 @@
 package validation
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	gomegatypes "github.com/onsi/gomega/types"
 	corev1 "k8s.io/api/core/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 )
 
 var _ = Describe("Secret validation", func() {
@@ -47,5 +58,91 @@ var _ = Describe("Secret validation", func() {
 				"username":  []byte("admin"),
 			},
 			Not(HaveOccurred())),
+		Entry("should return an error if no credentials are set",
+			map[string][]byte{
+				"namespace": []byte("foo"),
+			}, MatchError(ContainSubstring("missing credentials"))),
+		Entry("should return an error if multiple credentials are set",
+			map[string][]byte{
+				"namespace":  []byte("foo"),
+				"token":      []byte("foo"),
+				"username":   []byte("admin"),
+				"kubeconfig": kubeconfig("https://api.example.com", "token: foo"),
+			}, MatchError(ContainSubstring("only one of"))),
+		Entry("should return no error for a valid client certificate",
+			map[string][]byte{
+				"namespace":         []byte("foo"),
+				"clientCertificate": clientCertificate,
+				"clientKey":         clientKey,
+			}, Not(HaveOccurred())),
+		Entry("should return an error if the client key is missing",
+			map[string][]byte{
+				"namespace":         []byte("foo"),
+				"clientCertificate": clientCertificate,
+			}, MatchError("missing field: clientKey in cloud provider secret")),
+		Entry("should return an error if the client certificate does not match the key",
+			map[string][]byte{
+				"namespace":         []byte("foo"),
+				"clientCertificate": []byte("invalid"),
+				"clientKey":         clientKey,
+			}, MatchError(ContainSubstring("invalid field: clientCertificate"))),
+		Entry("should return no error for a valid kubeconfig",
+			map[string][]byte{
+				"namespace":  []byte("foo"),
+				"kubeconfig": kubeconfig("https://api.example.com", "token: foo"),
+			}, Not(HaveOccurred())),
+		Entry("should return an error for a kubeconfig with a credential plugin",
+			map[string][]byte{
+				"namespace":  []byte("foo"),
+				"kubeconfig": kubeconfig("https://api.example.com", "exec:\n      apiVersion: client.authentication.k8s.io/v1\n      command: /bin/evil"),
+			}, MatchError(ContainSubstring("must not use a credential plugin"))),
+		Entry("should return an error for a kubeconfig referencing a token file",
+			map[string][]byte{
+				"namespace":  []byte("foo"),
+				"kubeconfig": kubeconfig("https://api.example.com", "tokenFile: /var/run/secrets/token"),
+			}, MatchError(ContainSubstring("must not reference files"))),
+		Entry("should return an error for a kubeconfig with a non-https server",
+			map[string][]byte{
+				"namespace":  []byte("foo"),
+				"kubeconfig": kubeconfig("http://api.example.com", "token: foo"),
+			}, MatchError(ContainSubstring("must be a valid https URL"))),
 	)
 })
+
+var clientCertificate, clientKey = func() ([]byte, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	utilruntime.Must(err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "admin"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	cert, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	utilruntime.Must(err)
+	keyBytes, err := x509.MarshalECPrivateKey(key)
+	utilruntime.Must(err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyBytes})
+}()
+
+func kubeconfig(server, user string) []byte {
+	return []byte(fmt.Sprintf(`apiVersion: v1
+kind: Config
+current-context: ironcore
+clusters:
+- name: ironcore
+  cluster:
+    server: %s
+contexts:
+- name: ironcore
+  context:
+    cluster: ironcore
+    user: admin
+users:
+- name: admin
+  user:
+    %s
+`, server, user))
+}
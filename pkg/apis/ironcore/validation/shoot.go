@@ -4,11 +4,19 @@
 package validation
 
 import (
+	"fmt"
+	"slices"
+
 	"github.com/gardener/gardener/pkg/api/core/helper"
 	"github.com/gardener/gardener/pkg/apis/core"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	validationutils "github.com/gardener/gardener/pkg/utils/validation"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	apisironcore "github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/apis/ironcore"
 )
 
 // ValidateNetworking validates the network settings of a Shoot.
@@ -56,6 +64,21 @@ func validateVolume(vol *core.Volume, fldPath *field.Path) field.ErrorList {
 	return allErrs
 }
 
+// ValidateNetworkingUpdate validates updates on the network settings of a Shoot.
+func ValidateNetworkingUpdate(oldNetworking, newNetworking *core.Networking, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if oldNetworking != nil && oldNetworking.Nodes != nil {
+		var newNodes *string
+		if newNetworking != nil {
+			newNodes = newNetworking.Nodes
+		}
+		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newNodes, oldNetworking.Nodes, fldPath.Child("nodes"))...)
+	}
+
+	return allErrs
+}
+
 // ValidateWorkersUpdate validates updates on Workers.
 func ValidateWorkersUpdate(oldWorkers, newWorkers []core.Worker, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -63,9 +86,93 @@ func ValidateWorkersUpdate(oldWorkers, newWorkers []core.Worker, fldPath *field.
 		workerFldPath := fldPath.Index(i)
 		oldWorker := helper.FindWorkerByName(oldWorkers, newWorker.Name)
 
-		if oldWorker != nil && validationutils.ShouldEnforceImmutability(newWorker.Zones, oldWorker.Zones) {
+		if oldWorker == nil {
+			continue
+		}
+
+		if validationutils.ShouldEnforceImmutability(newWorker.Zones, oldWorker.Zones) {
 			allErrs = append(allErrs, apivalidation.ValidateImmutableField(newWorker.Zones, oldWorker.Zones, workerFldPath.Child("zones"))...)
 		}
+
+		// The volume class of the root disk can only be changed by replacing the machines.
+		if helper.IsUpdateStrategyInPlace(newWorker.UpdateStrategy) && oldWorker.Volume != nil && newWorker.Volume != nil &&
+			!ptr.Equal(oldWorker.Volume.Type, newWorker.Volume.Type) {
+			allErrs = append(allErrs, field.Forbidden(workerFldPath.Child("volume", "type"),
+				fmt.Sprintf("the volume type can't be changed for worker pools with update strategy %q, it requires a rolling update", *newWorker.UpdateStrategy)))
+		}
 	}
 	return allErrs
 }
+
+// ValidateWorkersAgainstCloudProfile validates that the machine images, volume types and zones of the given workers
+// can be mapped with the given CloudProfileConfig. Zones are only validated if the RegionConfig of the given region
+// configures zones. Workers which exist in oldWorkers are only validated if their machine image, architecture or
+// volume type changed, or if zones were added.
+func ValidateWorkersAgainstCloudProfile(oldWorkers, workers []core.Worker, region string, cpConfig *apisironcore.CloudProfileConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	providerImages := NewProviderImagesContext(cpConfig.MachineImages)
+
+	zones := sets.New[string]()
+	for _, regionConfig := range cpConfig.RegionConfigs {
+		if regionConfig.Name == region {
+			for _, zone := range regionConfig.Zones {
+				zones.Insert(zone.Name)
+			}
+		}
+	}
+
+	volumeTypes := sets.New[string]()
+	if cpConfig.StorageClasses.Default != nil {
+		volumeTypes.Insert(cpConfig.StorageClasses.Default.Type)
+	}
+	for _, sc := range cpConfig.StorageClasses.Additional {
+		volumeTypes.Insert(sc.Type)
+	}
+
+	for i, worker := range workers {
+		workerFldPath := fldPath.Index(i)
+		oldWorker := helper.FindWorkerByName(oldWorkers, worker.Name)
+
+		if image := worker.Machine.Image; image != nil && len(image.Version) > 0 {
+			arch := ptr.Deref(worker.Machine.Architecture, v1beta1constants.ArchitectureAMD64)
+			if oldWorker == nil || machineImageChanged(oldWorker.Machine, worker.Machine) {
+				imagePath := workerFldPath.Child("machine", "image")
+				if _, ok := providerImages.GetImage(image.Name); !ok {
+					allErrs = append(allErrs, field.Invalid(imagePath.Child("name"), image.Name,
+						fmt.Sprintf("no provider image mapping for image %q in the CloudProfile", image.Name)))
+				} else if _, ok := providerImages.GetImageVersion(image.Name, VersionArchitectureKey(image.Version, arch)); !ok {
+					allErrs = append(allErrs, field.Invalid(imagePath.Child("version"), image.Version,
+						fmt.Sprintf("no provider image mapping for image %q in version %q and architecture %q in the CloudProfile", image.Name, image.Version, arch)))
+				}
+			}
+		}
+
+		// Volume types without a StorageClass are fine if StorageClasses are generated for all VolumeClasses.
+		if worker.Volume != nil && worker.Volume.Type != nil && !cpConfig.StorageClasses.DiscoverVolumeClasses {
+			if oldWorker == nil || oldWorker.Volume == nil || !ptr.Equal(oldWorker.Volume.Type, worker.Volume.Type) {
+				if !volumeTypes.Has(*worker.Volume.Type) {
+					allErrs = append(allErrs, field.NotSupported(workerFldPath.Child("volume", "type"), *worker.Volume.Type, sets.List(volumeTypes)))
+				}
+			}
+		}
+
+		if zones.Len() > 0 {
+			for j, zone := range worker.Zones {
+				if (oldWorker == nil || !slices.Contains(oldWorker.Zones, zone)) && !zones.Has(zone) {
+					allErrs = append(allErrs, field.NotSupported(workerFldPath.Child("zones").Index(j), zone, sets.List(zones)))
+				}
+			}
+		}
+	}
+
+	return allErrs
+}
+
+func machineImageChanged(oldMachine, newMachine core.Machine) bool {
+	if oldMachine.Image == nil || newMachine.Image == nil {
+		return oldMachine.Image != newMachine.Image
+	}
+	return oldMachine.Image.Name != newMachine.Image.Name ||
+		oldMachine.Image.Version != newMachine.Image.Version ||
+		!ptr.Equal(oldMachine.Architecture, newMachine.Architecture)
+}
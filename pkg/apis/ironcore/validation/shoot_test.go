@@ -0,0 +1,99 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"github.com/gardener/gardener/pkg/apis/core"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	apisironcore "github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/apis/ironcore"
+)
+
+var _ = Describe("Shoot validation", func() {
+	Describe("#ValidateWorkersAgainstCloudProfile", func() {
+		var (
+			cpConfig *apisironcore.CloudProfileConfig
+			workers  []core.Worker
+			fldPath  = field.NewPath("workers")
+		)
+
+		BeforeEach(func() {
+			cpConfig = &apisironcore.CloudProfileConfig{
+				MachineImages: []apisironcore.MachineImages{
+					{
+						Name: "gardenlinux",
+						Versions: []apisironcore.MachineImageVersion{
+							{Version: "1.0.0", Image: "registry/image:1.0.0", Architecture: ptr.To("amd64")},
+							{Version: "1.1.0", Image: "registry/image:1.1.0-arm64", Architecture: ptr.To("arm64")},
+						},
+					},
+				},
+				StorageClasses: apisironcore.StorageClasses{
+					Default:    &apisironcore.StorageClass{Name: "default", Type: "general-purpose"},
+					Additional: []apisironcore.StorageClass{{Name: "fast", Type: "io-optimized"}},
+				},
+			}
+			workers = []core.Worker{
+				{
+					Name: "worker",
+					Machine: core.Machine{
+						Image:        &core.ShootMachineImage{Name: "gardenlinux", Version: "1.0.0"},
+						Architecture: ptr.To("amd64"),
+					},
+					Volume: &core.Volume{Type: ptr.To("general-purpose"), VolumeSize: "10Gi"},
+				},
+			}
+		})
+
+		It("should accept workers which can be mapped", func() {
+			workers[0].Volume.Type = ptr.To("io-optimized")
+			Expect(ValidateWorkersAgainstCloudProfile(nil, workers, "foo", cpConfig, fldPath)).To(BeEmpty())
+		})
+
+		It("should reject machine images without a provider image mapping", func() {
+			workers[0].Machine.Image.Name = "ubuntu"
+			Expect(ValidateWorkersAgainstCloudProfile(nil, workers, "foo", cpConfig, fldPath)).To(ConsistOf(
+				InvalidField("workers[0].machine.image.name"),
+			))
+		})
+
+		It("should reject machine image versions and architectures without a provider image mapping", func() {
+			workers[0].Machine.Image.Version = "1.1.0"
+			Expect(ValidateWorkersAgainstCloudProfile(nil, workers, "foo", cpConfig, fldPath)).To(ConsistOf(
+				InvalidField("workers[0].machine.image.version"),
+			))
+
+			workers[0].Machine.Architecture = ptr.To("arm64")
+			Expect(ValidateWorkersAgainstCloudProfile(nil, workers, "foo", cpConfig, fldPath)).To(BeEmpty())
+		})
+
+		It("should reject volume types which are not mapped by a storage class", func() {
+			workers[0].Volume.Type = ptr.To("standard")
+			Expect(ValidateWorkersAgainstCloudProfile(nil, workers, "foo", cpConfig, fldPath)).To(ConsistOf(
+				SimpleMatchField(field.ErrorTypeNotSupported, "workers[0].volume.type"),
+			))
+		})
+
+		It("should accept any volume type if volume classes are discovered", func() {
+			workers[0].Volume.Type = ptr.To("standard")
+			cpConfig.StorageClasses.DiscoverVolumeClasses = true
+			Expect(ValidateWorkersAgainstCloudProfile(nil, workers, "foo", cpConfig, fldPath)).To(BeEmpty())
+		})
+
+		It("should not validate unchanged workers on update", func() {
+			workers[0].Machine.Image.Name = "ubuntu"
+			workers[0].Volume.Type = ptr.To("standard")
+			oldWorkers := []core.Worker{*workers[0].DeepCopy()}
+			Expect(ValidateWorkersAgainstCloudProfile(oldWorkers, workers, "foo", cpConfig, fldPath)).To(BeEmpty())
+
+			workers[0].Machine.Architecture = ptr.To("arm64")
+			Expect(ValidateWorkersAgainstCloudProfile(oldWorkers, workers, "foo", cpConfig, fldPath)).To(ConsistOf(
+				InvalidField("workers[0].machine.image.name"),
+			))
+		})
+	})
+})
@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"fmt"
+	"net"
+	"slices"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	apisironcore "github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/apis/ironcore"
+)
+
+// minWorkerPriority is the lowest priority of a MachineDeployment, it matches the lower bound Gardener enforces for
+// the priority of a worker pool.
+const minWorkerPriority int32 = -1
+
+// primaryNetworkInterfaceName is the name of the network interface which the machine-controller-manager attaches to
+// the network of the shoot.
+const primaryNetworkInterfaceName = "primary"
+
+// ValidateWorkerConfig validates the WorkerConfig object of the given worker pool.
+func ValidateWorkerConfig(workerConfig *apisironcore.WorkerConfig, worker core.Worker, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	zones := worker.Zones
+
+	if workerConfig.Priority != nil {
+		allErrs = append(allErrs, validatePriority(*workerConfig.Priority, fldPath.Child("priority"))...)
+	}
+
+	seenZones := sets.New[string]()
+	for i, zonePriority := range workerConfig.ZonePriorities {
+		idxPath := fldPath.Child("zonePriorities").Index(i)
+
+		switch {
+		case zonePriority.Zone == "":
+			allErrs = append(allErrs, field.Required(idxPath.Child("zone"), "must not be empty"))
+		case seenZones.Has(zonePriority.Zone):
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("zone"), zonePriority.Zone))
+		case !slices.Contains(zones, zonePriority.Zone):
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("zone"), zonePriority.Zone, zones))
+		}
+		seenZones.Insert(zonePriority.Zone)
+
+		allErrs = append(allErrs, validatePriority(zonePriority.Priority, idxPath.Child("priority"))...)
+	}
+
+	seenNames := sets.New[string]()
+	for i, networkInterface := range workerConfig.NetworkInterfaces {
+		idxPath := fldPath.Child("networkInterfaces").Index(i)
+
+		switch {
+		case networkInterface.Name == "":
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must not be empty"))
+		case networkInterface.Name == primaryNetworkInterfaceName:
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("name"), "is reserved for the network interface in the network of the shoot"))
+		case seenNames.Has(networkInterface.Name):
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), networkInterface.Name))
+		default:
+			for _, msg := range apivalidation.NameIsDNSLabel(networkInterface.Name, false) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), networkInterface.Name, msg))
+			}
+		}
+		seenNames.Insert(networkInterface.Name)
+
+		if networkInterface.NetworkName == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("networkName"), "must not be empty"))
+		} else {
+			for _, msg := range apivalidation.NameIsDNSSubdomain(networkInterface.NetworkName, false) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("networkName"), networkInterface.NetworkName, msg))
+			}
+		}
+
+		// Static prefixes are routed to the network interface of every machine of the worker pool, so they can only be
+		// used by a single machine at a time.
+		if len(networkInterface.Prefixes) > 0 && !isSingleMachinePool(worker) {
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("prefixes"), "static prefixes are only supported for worker pools with a maximum of one machine and a maxSurge of 0"))
+		}
+
+		seenPrefixes := sets.New[string]()
+		for j, prefix := range networkInterface.Prefixes {
+			prefixPath := idxPath.Child("prefixes").Index(j)
+			if _, _, err := net.ParseCIDR(prefix); err != nil {
+				allErrs = append(allErrs, field.Invalid(prefixPath, prefix, "must be a valid CIDR"))
+			} else if seenPrefixes.Has(prefix) {
+				allErrs = append(allErrs, field.Duplicate(prefixPath, prefix))
+			}
+			seenPrefixes.Insert(prefix)
+		}
+
+		allErrs = append(allErrs, metav1validation.ValidateLabels(networkInterface.Labels, idxPath.Child("labels"))...)
+	}
+
+	return allErrs
+}
+
+// isSingleMachinePool returns true if the given worker pool never runs more than one machine, also not while its
+// machines are rolled.
+func isSingleMachinePool(worker core.Worker) bool {
+	if worker.Maximum > 1 || worker.MaxSurge == nil {
+		return false
+	}
+	maxSurge, err := intstr.GetScaledValueFromIntOrPercent(worker.MaxSurge, int(worker.Maximum), true)
+	return err == nil && maxSurge == 0
+}
+
+func validatePriority(priority int32, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if priority < minWorkerPriority {
+		allErrs = append(allErrs, field.Invalid(fldPath, priority, fmt.Sprintf("must not be less than %d", minWorkerPriority)))
+	}
+	return allErrs
+}
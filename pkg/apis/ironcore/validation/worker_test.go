@@ -0,0 +1,125 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"github.com/gardener/gardener/pkg/apis/core"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	apisironcore "github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/apis/ironcore"
+)
+
+var _ = Describe("WorkerConfig validation", func() {
+	var (
+		workerConfig *apisironcore.WorkerConfig
+		worker       core.Worker
+		fldPath      *field.Path
+	)
+
+	BeforeEach(func() {
+		workerConfig = &apisironcore.WorkerConfig{
+			Priority: ptr.To(int32(10)),
+			ZonePriorities: []apisironcore.ZonePriority{
+				{Zone: "zone-a", Priority: 20},
+				{Zone: "zone-b", Priority: -1},
+			},
+			NetworkInterfaces: []apisironcore.NetworkInterfaceConfig{{
+				Name:        "storage",
+				NetworkName: "storage-network",
+				Prefixes:    []string{"10.10.0.0/24", "fd00::/64"},
+				Labels:      map[string]string{"network.example.com/role": "storage"},
+			}},
+		}
+		worker = core.Worker{
+			Zones:    []string{"zone-a", "zone-b"},
+			Maximum:  1,
+			MaxSurge: ptr.To(intstr.FromInt32(0)),
+		}
+		fldPath = field.NewPath("providerConfig")
+	})
+
+	Describe("#ValidateWorkerConfig", func() {
+		It("should return no errors for a valid configuration", func() {
+			Expect(ValidateWorkerConfig(workerConfig, worker, fldPath)).To(BeEmpty())
+		})
+
+		It("should return no errors for an empty configuration", func() {
+			Expect(ValidateWorkerConfig(&apisironcore.WorkerConfig{}, worker, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid priorities below the lower bound", func() {
+			workerConfig.Priority = ptr.To(int32(-2))
+			workerConfig.ZonePriorities[1].Priority = -5
+
+			Expect(ValidateWorkerConfig(workerConfig, worker, fldPath)).To(ConsistOf(
+				InvalidField("providerConfig.priority"),
+				InvalidField("providerConfig.zonePriorities[1].priority"),
+			))
+		})
+
+		It("should forbid priorities of unknown, duplicate or empty zones", func() {
+			workerConfig.ZonePriorities = append(workerConfig.ZonePriorities,
+				apisironcore.ZonePriority{Zone: "zone-a", Priority: 1},
+				apisironcore.ZonePriority{Zone: "zone-c", Priority: 1},
+				apisironcore.ZonePriority{Priority: 1},
+			)
+
+			Expect(ValidateWorkerConfig(workerConfig, worker, fldPath)).To(ConsistOf(
+				SimpleMatchField(field.ErrorTypeDuplicate, "providerConfig.zonePriorities[2].zone"),
+				SimpleMatchField(field.ErrorTypeNotSupported, "providerConfig.zonePriorities[3].zone"),
+				SimpleMatchField(field.ErrorTypeRequired, "providerConfig.zonePriorities[4].zone"),
+			))
+		})
+
+		It("should forbid network interfaces with missing, duplicate, reserved or invalid names", func() {
+			workerConfig.NetworkInterfaces = append(workerConfig.NetworkInterfaces,
+				apisironcore.NetworkInterfaceConfig{Name: "storage", NetworkName: "other-network"},
+				apisironcore.NetworkInterfaceConfig{Name: "Backend", NetworkName: "backend_network"},
+				apisironcore.NetworkInterfaceConfig{Name: "backend", NetworkName: "backend.network"},
+				apisironcore.NetworkInterfaceConfig{},
+				apisironcore.NetworkInterfaceConfig{Name: "primary", NetworkName: "other-network"},
+			)
+
+			Expect(ValidateWorkerConfig(workerConfig, worker, fldPath)).To(ConsistOf(
+				SimpleMatchField(field.ErrorTypeDuplicate, "providerConfig.networkInterfaces[1].name"),
+				InvalidField("providerConfig.networkInterfaces[2].name"),
+				InvalidField("providerConfig.networkInterfaces[2].networkName"),
+				SimpleMatchField(field.ErrorTypeRequired, "providerConfig.networkInterfaces[4].name"),
+				SimpleMatchField(field.ErrorTypeRequired, "providerConfig.networkInterfaces[4].networkName"),
+				SimpleMatchField(field.ErrorTypeForbidden, "providerConfig.networkInterfaces[5].name"),
+			))
+		})
+
+		It("should forbid invalid or duplicate prefixes and invalid labels of network interfaces", func() {
+			workerConfig.NetworkInterfaces[0].Prefixes = append(workerConfig.NetworkInterfaces[0].Prefixes, "10.10.0.0/24", "10.10.0.0")
+			workerConfig.NetworkInterfaces[0].Labels["-invalid"] = "role"
+
+			Expect(ValidateWorkerConfig(workerConfig, worker, fldPath)).To(ConsistOf(
+				SimpleMatchField(field.ErrorTypeDuplicate, "providerConfig.networkInterfaces[0].prefixes[2]"),
+				InvalidField("providerConfig.networkInterfaces[0].prefixes[3]"),
+				InvalidField("providerConfig.networkInterfaces[0].labels"),
+			))
+		})
+
+		It("should forbid static prefixes of network interfaces in worker pools with more than one machine", func() {
+			worker.MaxSurge = ptr.To(intstr.FromString("10%"))
+			Expect(ValidateWorkerConfig(workerConfig, worker, fldPath)).To(ConsistOf(
+				SimpleMatchField(field.ErrorTypeForbidden, "providerConfig.networkInterfaces[0].prefixes"),
+			))
+
+			worker.MaxSurge = ptr.To(intstr.FromInt32(0))
+			worker.Maximum = 2
+			Expect(ValidateWorkerConfig(workerConfig, worker, fldPath)).To(ConsistOf(
+				SimpleMatchField(field.ErrorTypeForbidden, "providerConfig.networkInterfaces[0].prefixes"),
+			))
+
+			workerConfig.NetworkInterfaces[0].Prefixes = nil
+			Expect(ValidateWorkerConfig(workerConfig, worker, fldPath)).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,26 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	apisironcore "github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/apis/ironcore"
+)
+
+// ValidateWorkloadIdentityConfig validates a WorkloadIdentityConfig object.
+func ValidateWorkloadIdentityConfig(config *apisironcore.WorkloadIdentityConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(config.Namespace) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("namespace"), "must reference the ironcore namespace"))
+	} else {
+		for _, msg := range apivalidation.ValidateNamespaceName(config.Namespace, false) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("namespace"), config.Namespace, msg))
+		}
+	}
+
+	return allErrs
+}
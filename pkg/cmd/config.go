@@ -97,3 +97,10 @@ func (c *Config) ApplyBackupbucketConfig(config *config.BackupBucketConfig) {
 		*config = *c.Config.BackupBucketConfig
 	}
 }
+
+// ApplyIroncoreClientConfig applies the IroncoreClientConfig to the config
+func (c *Config) ApplyIroncoreClientConfig(config *config.IroncoreClientConfig) {
+	if c.Config.IroncoreClientConfig != nil {
+		*config = *c.Config.IroncoreClientConfig
+	}
+}
@@ -12,21 +12,25 @@ import (
 	"github.com/go-logr/logr"
 	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	controllerconfig "github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/apis/config"
+	"github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/apis/ironcore/helper"
 	"github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/ironcore"
 )
 
 type actuator struct {
 	backupBucketConfig *controllerconfig.BackupBucketConfig
 	client             client.Client
+	recorder           events.EventRecorder
 }
 
 func newActuator(mgr manager.Manager, backupBucketConfig *controllerconfig.BackupBucketConfig) backupbucket.Actuator {
 	return &actuator{
 		client:             mgr.GetClient(),
+		recorder:           mgr.GetEventRecorder(ironcore.ProviderName),
 		backupBucketConfig: backupBucketConfig,
 	}
 }
@@ -40,6 +44,11 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, backupBucket
 		return fmt.Errorf("failed to get ironcore client and namespace from cloudprovider secret: %w", err)
 	}
 
+	config, err := helper.BackupBucketConfigFromBackupBucket(backupBucket)
+	if err != nil {
+		return fmt.Errorf("failed to decode provider config: %w", err)
+	}
+
 	// If the generated secret in the backupbucket status not exists that means
 	// no backupbucket exists, and it needs to be created.
 	if backupBucket.Status.GeneratedSecretRef == nil {
@@ -51,6 +60,24 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, backupBucket
 			return fmt.Errorf("failed to ensure backupbucket: %w", err)
 		}
 	}
+
+	status, err := helper.BackupBucketStatusFromRaw(backupBucket.Status.ProviderStatus)
+	if err != nil {
+		return fmt.Errorf("failed to decode provider status: %w", err)
+	}
+	// The replica recorded in the status is removed if the provider config no longer asks for it, i.e. if the
+	// replication was disabled or moved to another region.
+	if status.Replication != nil && !isReplicaOf(status.Replication, config.Replication) {
+		if err := a.removeReplicaBucket(ctx, backupBucket, status.Replication); err != nil {
+			return fmt.Errorf("failed to remove replica bucket: %w", err)
+		}
+	}
+
+	if config.Replication != nil {
+		if err := a.ensureReplicaBucket(ctx, backupBucket, config.Replication); err != nil {
+			return fmt.Errorf("failed to ensure replica bucket: %w", err)
+		}
+	}
 	log.V(2).Info("Reconciled BackupBucket")
 	return nil
 }
@@ -68,10 +95,22 @@ func (a *actuator) Delete(ctx context.Context, log logr.Logger, backupBucket *ex
 			Namespace: namespace,
 		},
 	}
-	if err = ironcoreClient.Delete(ctx, bucket); err != nil {
+	if err = ironcore.DeleteAndRecord(ctx, ironcoreClient, a.recorder, backupBucket, bucket); err != nil {
 		return fmt.Errorf("failed to delete backup bucket: %v", err)
 	}
 
+	// The replica is deleted based on the status, the replication might have been removed from the provider config
+	// already.
+	status, err := helper.BackupBucketStatusFromRaw(backupBucket.Status.ProviderStatus)
+	if err != nil {
+		return fmt.Errorf("failed to decode provider status: %w", err)
+	}
+	if status.Replication != nil {
+		if err := a.deleteReplicaBucket(ctx, backupBucket, status.Replication); err != nil {
+			return err
+		}
+	}
+
 	log.V(2).Info("Deleted BackupBucket")
 	return nil
 }
@@ -4,6 +4,8 @@
 package backupbucket
 
 import (
+	"encoding/json"
+
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
@@ -13,10 +15,14 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/events"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	. "sigs.k8s.io/controller-runtime/pkg/envtest/komega"
 
 	controllerconfig "github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/apis/config"
+	"github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/apis/ironcore/helper"
+	apiv1alpha1 "github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/apis/ironcore/v1alpha1"
 	"github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/ironcore"
 )
 
@@ -118,6 +124,154 @@ var _ = Describe("Backupbucket Reconcile", Ordered, func() {
 		Eventually(Get(bucket)).Should(Satisfy(apierrors.IsNotFound))
 	})
 
+	It("should remove the replica bucket once the replication is removed from the provider config", func(ctx SpecContext) {
+		By("creating a backup bucket with a replication")
+		backupBucket = &extensionsv1alpha1.BackupBucket{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns.Name,
+				Name:      "my-replicated-backup-bucket",
+			},
+			Spec: extensionsv1alpha1.BackupBucketSpec{
+				DefaultSpec: extensionsv1alpha1.DefaultSpec{
+					Type: ironcore.Type,
+					ProviderConfig: &runtime.RawExtension{Raw: encodeObject(&apiv1alpha1.BackupBucketConfig{
+						TypeMeta: metav1.TypeMeta{
+							APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+							Kind:       "BackupBucketConfig",
+						},
+						Replication: &apiv1alpha1.BackupBucketReplication{
+							Region:    "europe-west",
+							SecretRef: corev1.SecretReference{Namespace: ns.Name, Name: "backupprovider"},
+						},
+					})},
+				},
+				Region: "europe-central",
+				SecretRef: corev1.SecretReference{
+					Name:      "backupprovider",
+					Namespace: ns.Name,
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, backupBucket)).Should(Succeed())
+
+		bucketAccessSecret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns.Name,
+				Name:      "my-replicated-bucket-secret",
+			},
+			Data: map[string][]byte{
+				"AWS_ACCESS_KEY_ID":     []byte("access-key-id"),
+				"AWS_SECRET_ACCESS_KEY": []byte("secret-access-key"),
+			},
+		}
+		Expect(k8sClient.Create(ctx, bucketAccessSecret)).To(Succeed())
+
+		By("making the primary and the replica bucket available")
+		bucket = &storagev1alpha1.Bucket{ObjectMeta: metav1.ObjectMeta{Namespace: ns.Name, Name: backupBucket.Name}}
+		replicaBucket := &storagev1alpha1.Bucket{ObjectMeta: metav1.ObjectMeta{Namespace: ns.Name, Name: backupBucket.Name + "-replica"}}
+		for _, b := range []*storagev1alpha1.Bucket{bucket, replicaBucket} {
+			Eventually(Get(b)).Should(Succeed())
+			bucketBase := b.DeepCopy()
+			b.Status.State = storagev1alpha1.BucketStateAvailable
+			b.Status.Access = &storagev1alpha1.BucketAccess{
+				SecretRef: &corev1.LocalObjectReference{Name: bucketAccessSecret.Name},
+				Endpoint:  b.Name + ".storage",
+			}
+			Expect(k8sClient.Status().Patch(ctx, b, client.MergeFrom(bucketBase))).To(Succeed())
+		}
+
+		By("ensuring that the replica is recorded in the generated secret and the status")
+		generatedSecret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns.Name,
+				Name:      v1beta1constants.SecretPrefixGeneratedBackupBucket + backupBucket.Name,
+			},
+		}
+		Eventually(Object(generatedSecret)).Should(HaveField("Data", HaveKeyWithValue(ironcore.ReplicaBucketName, []byte(replicaBucket.Name))))
+		Eventually(func(g Gomega) {
+			g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(backupBucket), backupBucket)).To(Succeed())
+			status, err := helper.BackupBucketStatusFromRaw(backupBucket.Status.ProviderStatus)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(status.Replication).To(SatisfyAll(
+				HaveField("Region", "europe-west"),
+				HaveField("BucketName", replicaBucket.Name),
+			))
+		}).Should(Succeed())
+
+		By("removing the replication from the provider config")
+		Eventually(Update(backupBucket, func() {
+			backupBucket.Spec.ProviderConfig = nil
+		})).Should(Succeed())
+
+		By("ensuring that the replica bucket, its access details and its status are removed")
+		Eventually(Get(replicaBucket)).Should(Satisfy(apierrors.IsNotFound))
+		Eventually(Object(generatedSecret)).Should(HaveField("Data", SatisfyAll(
+			Not(HaveKey(ironcore.ReplicaBucketName)),
+			Not(HaveKey(ironcore.ReplicaAccessKeyID)),
+			Not(HaveKey(ironcore.ReplicaSecretAccessKey)),
+			Not(HaveKey(ironcore.ReplicaEndpoint)),
+			HaveKey(ironcore.AccessKeyID),
+		)))
+		Eventually(func(g Gomega) {
+			g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(backupBucket), backupBucket)).To(Succeed())
+			status, err := helper.BackupBucketStatusFromRaw(backupBucket.Status.ProviderStatus)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(status.Replication).To(BeNil())
+		}).Should(Succeed())
+
+		By("deleting the backup bucket")
+		Expect(k8sClient.Delete(ctx, backupBucket)).Should(Succeed())
+		Eventually(Get(bucket)).Should(Satisfy(apierrors.IsNotFound))
+	})
+
+	It("should delete the replica bucket recorded in the status on deletion", func(ctx SpecContext) {
+		By("creating the primary and the replica bucket")
+		bucket = &storagev1alpha1.Bucket{ObjectMeta: metav1.ObjectMeta{Namespace: ns.Name, Name: "my-orphaned-backup-bucket"}}
+		replicaBucket := &storagev1alpha1.Bucket{ObjectMeta: metav1.ObjectMeta{Namespace: ns.Name, Name: "my-orphaned-backup-bucket-replica"}}
+		for _, b := range []*storagev1alpha1.Bucket{bucket, replicaBucket} {
+			b.Spec.BucketClassRef = &corev1.LocalObjectReference{Name: "my-bucket-class"}
+			Expect(k8sClient.Create(ctx, b)).To(Succeed())
+		}
+
+		By("deleting a backup bucket whose replication was removed from the provider config only")
+		backupBucket = &extensionsv1alpha1.BackupBucket{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns.Name,
+				Name:      bucket.Name,
+			},
+			Spec: extensionsv1alpha1.BackupBucketSpec{
+				DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: ironcore.Type},
+				Region:      "europe-central",
+				SecretRef:   corev1.SecretReference{Namespace: ns.Name, Name: "backupprovider"},
+			},
+			Status: extensionsv1alpha1.BackupBucketStatus{
+				DefaultStatus: extensionsv1alpha1.DefaultStatus{
+					ProviderStatus: &runtime.RawExtension{Raw: encodeObject(&apiv1alpha1.BackupBucketStatus{
+						TypeMeta: metav1.TypeMeta{
+							APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+							Kind:       "BackupBucketStatus",
+						},
+						Replication: &apiv1alpha1.BackupBucketReplicationStatus{
+							Region:     "europe-west",
+							BucketName: replicaBucket.Name,
+							SecretRef:  corev1.SecretReference{Namespace: ns.Name, Name: "backupprovider"},
+						},
+					})},
+				},
+			},
+		}
+		a := &actuator{
+			client:             k8sClient,
+			recorder:           &events.FakeRecorder{},
+			backupBucketConfig: &controllerconfig.BackupBucketConfig{BucketClassName: "my-bucket-class"},
+		}
+		Expect(a.Delete(ctx, GinkgoLogr, backupBucket)).To(Succeed())
+
+		By("ensuring that both buckets are gone")
+		Eventually(Get(bucket)).Should(Satisfy(apierrors.IsNotFound))
+		Eventually(Get(replicaBucket)).Should(Satisfy(apierrors.IsNotFound))
+	})
+
 	It("should check backup bucket configuration", func(ctx SpecContext) {
 		By("validating backupbucket config")
 		Expect(validateConfiguration(nil)).To(MatchError("backupBucketConfig must not be empty"))
@@ -133,3 +287,8 @@ var _ = Describe("Backupbucket Reconcile", Ordered, func() {
 		Expect(validateConfiguration(config)).To(Succeed())
 	})
 })
+
+func encodeObject(obj runtime.Object) []byte {
+	data, _ := json.Marshal(obj)
+	return data
+}
@@ -5,6 +5,7 @@ package backupbucket
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/gardener/gardener/extensions/pkg/controller/backupbucket"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
@@ -35,8 +36,20 @@ type AddOptions struct {
 // AddToManagerWithOptions adds a controller with the given Options to the given manager.
 // The opts.Reconciler is being set with a newly instantiated actuator.
 func AddToManagerWithOptions(ctx context.Context, mgr manager.Manager, opts AddOptions) error {
+	replicationInterval := defaultReplicationInterval
+	if opts.BackupBucketConfig.ReplicationInterval != nil {
+		replicationInterval = opts.BackupBucketConfig.ReplicationInterval.Duration
+	}
+	if err := mgr.Add(&replicator{
+		client:   mgr.GetClient(),
+		log:      mgr.GetLogger().WithName("backupbucket-replicator"),
+		interval: replicationInterval,
+	}); err != nil {
+		return fmt.Errorf("failed to add backup bucket replicator: %w", err)
+	}
+
 	return backupbucket.Add(mgr, backupbucket.AddArgs{
-		Actuator:          newActuator(mgr, &opts.BackupBucketConfig),
+		Actuator:          &metricsActuator{Actuator: newActuator(mgr, &opts.BackupBucketConfig)},
 		ControllerOptions: opts.Controller,
 		Predicates:        backupbucket.DefaultPredicates(opts.IgnoreOperationAnnotation),
 		Type:              ironcore.Type,
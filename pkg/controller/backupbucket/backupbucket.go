@@ -13,11 +13,15 @@ import (
 	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	controllerconfig "github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/apis/config"
+	apisironcore "github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/apis/ironcore"
+	"github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/apis/ironcore/helper"
+	apiv1alpha1 "github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/apis/ironcore/v1alpha1"
 	"github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/ironcore"
 )
 
@@ -41,9 +45,11 @@ func (a *actuator) ensureBackupBucket(ctx context.Context, namespace string, iro
 		},
 	}
 	//create ironcore bucket
-	if _, err := controllerutil.CreateOrPatch(ctx, ironcoreClient, bucket, nil); err != nil {
+	result, err := controllerutil.CreateOrPatch(ctx, ironcoreClient, bucket, nil)
+	if err != nil {
 		return fmt.Errorf("failed to create or patch backup bucket %s: %w", client.ObjectKeyFromObject(bucket), err)
 	}
+	ironcore.RecordApplyEvent(a.recorder, backupBucket, bucket, result)
 	//wait for bucket creation
 	if err := waitBackupBucketToAvailable(ctx, ironcoreClient, bucket); err != nil {
 		return fmt.Errorf("could not determine status of backup bucket %w", err)
@@ -88,23 +94,14 @@ func isBucketAccessDetailsAvailable(bucket *storagev1alpha1.Bucket) bool {
 
 // patchBackupBucketStatus updates backupBucket status with access secretRef
 func (a *actuator) patchBackupBucketStatus(ctx context.Context, backupBucket *extensionsv1alpha1.BackupBucket, secretData map[string][]byte, endpoint string) error {
-	if secretData == nil {
-		return fmt.Errorf("secret does not contain any data")
-	}
-
-	accessKeyID, ok := secretData[ironcore.BucketAccessKeyID]
-	if !ok {
-		return fmt.Errorf("missing %q field in secret", ironcore.BucketAccessKeyID)
-	}
-
-	secretAccessKey, ok := secretData[ironcore.BucketSecretAccessKey]
-	if !ok {
-		return fmt.Errorf("missing %q field in secret", ironcore.BucketSecretAccessKey)
+	accessKeyID, secretAccessKey, err := bucketCredentials(secretData)
+	if err != nil {
+		return err
 	}
 
 	accessSecretData := map[string][]byte{}
-	accessSecretData[ironcore.AccessKeyID] = []byte(accessKeyID)
-	accessSecretData[ironcore.SecretAccessKey] = []byte(secretAccessKey)
+	accessSecretData[ironcore.AccessKeyID] = accessKeyID
+	accessSecretData[ironcore.SecretAccessKey] = secretAccessKey
 	accessSecretData[ironcore.Endpoint] = []byte(endpoint)
 
 	patch := client.MergeFrom(backupBucket.DeepCopy())
@@ -132,6 +129,189 @@ func (a *actuator) patchBackupBucketStatus(ctx context.Context, backupBucket *ex
 	return a.client.Status().Patch(ctx, backupBucket, patch)
 }
 
+// bucketCredentials extracts the access key id and secret access key from an ironcore bucket access secret.
+func bucketCredentials(secretData map[string][]byte) ([]byte, []byte, error) {
+	if secretData == nil {
+		return nil, nil, fmt.Errorf("secret does not contain any data")
+	}
+
+	accessKeyID, ok := secretData[ironcore.BucketAccessKeyID]
+	if !ok {
+		return nil, nil, fmt.Errorf("missing %q field in secret", ironcore.BucketAccessKeyID)
+	}
+
+	secretAccessKey, ok := secretData[ironcore.BucketSecretAccessKey]
+	if !ok {
+		return nil, nil, fmt.Errorf("missing %q field in secret", ironcore.BucketSecretAccessKey)
+	}
+
+	return accessKeyID, secretAccessKey, nil
+}
+
+// replicaBucketName returns the name of the secondary ironcore bucket of the given BackupBucket.
+func replicaBucketName(backupBucket *extensionsv1alpha1.BackupBucket) string {
+	return backupBucket.Name + "-replica"
+}
+
+// ensureReplicaBucket creates the secondary ironcore bucket in the replication region and adds its access
+// details to the generated secret of the BackupBucket.
+func (a *actuator) ensureReplicaBucket(ctx context.Context, backupBucket *extensionsv1alpha1.BackupBucket, replication *apisironcore.BackupBucketReplication) error {
+	ironcoreClient, namespace, err := ironcore.GetIroncoreClientAndNamespaceFromSecretRef(ctx, a.client, &replication.SecretRef)
+	if err != nil {
+		return fmt.Errorf("failed to get ironcore client and namespace for replication region %s: %w", replication.Region, err)
+	}
+
+	bucketClassName := a.backupBucketConfig.BucketClassName
+	if replication.BucketClassName != nil {
+		bucketClassName = *replication.BucketClassName
+	}
+
+	bucket := &storagev1alpha1.Bucket{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      replicaBucketName(backupBucket),
+			Namespace: namespace,
+		},
+		Spec: storagev1alpha1.BucketSpec{
+			BucketClassRef: &corev1.LocalObjectReference{
+				Name: bucketClassName,
+			},
+		},
+	}
+	result, err := controllerutil.CreateOrPatch(ctx, ironcoreClient, bucket, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create or patch replica bucket %s: %w", client.ObjectKeyFromObject(bucket), err)
+	}
+	ironcore.RecordApplyEvent(a.recorder, backupBucket, bucket, result)
+	if err := waitBackupBucketToAvailable(ctx, ironcoreClient, bucket); err != nil {
+		return fmt.Errorf("could not determine status of replica bucket %w", err)
+	}
+
+	accessSecret := &corev1.Secret{}
+	if err := ironcoreClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: bucket.Status.Access.SecretRef.Name}, accessSecret); err != nil {
+		return fmt.Errorf("failed to get replica bucket access secret %s: %w", client.ObjectKeyFromObject(accessSecret), err)
+	}
+	accessKeyID, secretAccessKey, err := bucketCredentials(accessSecret.Data)
+	if err != nil {
+		return err
+	}
+
+	backupBucketSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      backupBucket.Status.GeneratedSecretRef.Name,
+			Namespace: backupBucket.Status.GeneratedSecretRef.Namespace,
+		},
+	}
+	if _, err := controllerutil.CreateOrPatch(ctx, a.client, backupBucketSecret, func() error {
+		if backupBucketSecret.Data == nil {
+			backupBucketSecret.Data = map[string][]byte{}
+		}
+		backupBucketSecret.Data[ironcore.ReplicaBucketName] = []byte(bucket.Name)
+		backupBucketSecret.Data[ironcore.ReplicaAccessKeyID] = accessKeyID
+		backupBucketSecret.Data[ironcore.ReplicaSecretAccessKey] = secretAccessKey
+		backupBucketSecret.Data[ironcore.ReplicaEndpoint] = []byte(bucket.Status.Access.Endpoint)
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed to add replica bucket access to generated secret %s: %w", client.ObjectKeyFromObject(backupBucketSecret), err)
+	}
+
+	status, err := helper.BackupBucketStatusFromRaw(backupBucket.Status.ProviderStatus)
+	if err != nil {
+		return fmt.Errorf("failed to decode provider status: %w", err)
+	}
+	replicationStatus := &apisironcore.BackupBucketReplicationStatus{
+		Region:     replication.Region,
+		BucketName: bucket.Name,
+		SecretRef:  replication.SecretRef,
+	}
+	if status.Replication != nil && isReplicaOf(status.Replication, replication) && status.Replication.BucketName == replicationStatus.BucketName {
+		replicationStatus = status.Replication
+	}
+	return patchReplicationStatus(ctx, a.client, backupBucket, replicationStatus)
+}
+
+// isReplicaOf returns whether the replica of the given status was created for the given replication.
+func isReplicaOf(status *apisironcore.BackupBucketReplicationStatus, replication *apisironcore.BackupBucketReplication) bool {
+	return replication != nil && status.Region == replication.Region && status.SecretRef == replication.SecretRef
+}
+
+// removeReplicaBucket deletes the given replica of the BackupBucket, drops its access details from the generated
+// secret and clears the replication status, which stops the replicator.
+func (a *actuator) removeReplicaBucket(ctx context.Context, backupBucket *extensionsv1alpha1.BackupBucket, replication *apisironcore.BackupBucketReplicationStatus) error {
+	if err := a.deleteReplicaBucket(ctx, backupBucket, replication); err != nil {
+		return err
+	}
+
+	if backupBucket.Status.GeneratedSecretRef != nil {
+		if err := a.removeReplicaBucketAccess(ctx, backupBucket.Status.GeneratedSecretRef); err != nil {
+			return err
+		}
+	}
+
+	return patchReplicationStatus(ctx, a.client, backupBucket, nil)
+}
+
+// removeReplicaBucketAccess removes the access details of the replica bucket from the given generated secret.
+func (a *actuator) removeReplicaBucketAccess(ctx context.Context, secretRef *corev1.SecretReference) error {
+	backupBucketSecret := &corev1.Secret{}
+	if err := a.client.Get(ctx, client.ObjectKey{Namespace: secretRef.Namespace, Name: secretRef.Name}, backupBucketSecret); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+
+	patch := client.MergeFrom(backupBucketSecret.DeepCopy())
+	for _, key := range []string{ironcore.ReplicaBucketName, ironcore.ReplicaAccessKeyID, ironcore.ReplicaSecretAccessKey, ironcore.ReplicaEndpoint} {
+		delete(backupBucketSecret.Data, key)
+	}
+	if err := a.client.Patch(ctx, backupBucketSecret, patch); err != nil {
+		return fmt.Errorf("failed to remove replica bucket access from generated secret %s: %w", client.ObjectKeyFromObject(backupBucketSecret), err)
+	}
+	return nil
+}
+
+// deleteReplicaBucket deletes the secondary ironcore bucket of the given replication status.
+func (a *actuator) deleteReplicaBucket(ctx context.Context, backupBucket *extensionsv1alpha1.BackupBucket, replication *apisironcore.BackupBucketReplicationStatus) error {
+	ironcoreClient, namespace, err := ironcore.GetIroncoreClientAndNamespaceFromSecretRef(ctx, a.client, &replication.SecretRef)
+	if err != nil {
+		return fmt.Errorf("failed to get ironcore client and namespace for replication region %s: %w", replication.Region, err)
+	}
+
+	bucket := &storagev1alpha1.Bucket{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      replication.BucketName,
+			Namespace: namespace,
+		},
+	}
+	if err := ironcore.DeleteAndRecord(ctx, ironcoreClient, a.recorder, backupBucket, bucket); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed to delete replica bucket %s: %w", client.ObjectKeyFromObject(bucket), err)
+	}
+	return nil
+}
+
+// patchReplicationStatus sets the replication state in the provider status of the given BackupBucket.
+func patchReplicationStatus(ctx context.Context, c client.Client, backupBucket *extensionsv1alpha1.BackupBucket, replication *apisironcore.BackupBucketReplicationStatus) error {
+	backupBucketStatus := &apiv1alpha1.BackupBucketStatus{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+			Kind:       "BackupBucketStatus",
+		},
+	}
+	if replication != nil {
+		backupBucketStatus.Replication = &apiv1alpha1.BackupBucketReplicationStatus{
+			Region:              replication.Region,
+			BucketName:          replication.BucketName,
+			SecretRef:           replication.SecretRef,
+			LastReplicationTime: replication.LastReplicationTime,
+			PendingObjects:      replication.PendingObjects,
+			Lag:                 replication.Lag,
+		}
+	}
+
+	// The replicator and the actuator both write the replication status, a stale write must not bring back a removed
+	// replica.
+	patch := client.MergeFromWithOptions(backupBucket.DeepCopy(), client.MergeFromWithOptimisticLock{})
+	backupBucket.Status.ProviderStatus = &runtime.RawExtension{Object: backupBucketStatus}
+	return c.Status().Patch(ctx, backupBucket, patch)
+}
+
 // validateConfiguration checks whether a backup bucket configuration is valid.
 func validateConfiguration(config *controllerconfig.BackupBucketConfig) error {
 	if config == nil {
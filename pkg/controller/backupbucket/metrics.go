@@ -0,0 +1,31 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package backupbucket
+
+import (
+	"context"
+
+	"github.com/gardener/gardener/extensions/pkg/controller/backupbucket"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+
+	"github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/metrics"
+)
+
+// metricsActuator records the duration and the result of the operations of the wrapped backupbucket actuator.
+type metricsActuator struct {
+	backupbucket.Actuator
+}
+
+func (a *metricsActuator) Reconcile(ctx context.Context, log logr.Logger, backupBucket *extensionsv1alpha1.BackupBucket) error {
+	return metrics.ObserveActuator(backupbucket.ControllerName, metrics.OperationReconcile, func() error {
+		return a.Actuator.Reconcile(ctx, log, backupBucket)
+	})
+}
+
+func (a *metricsActuator) Delete(ctx context.Context, log logr.Logger, backupBucket *extensionsv1alpha1.BackupBucket) error {
+	return metrics.ObserveActuator(backupbucket.ControllerName, metrics.OperationDelete, func() error {
+		return a.Actuator.Delete(ctx, log, backupBucket)
+	})
+}
@@ -0,0 +1,142 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package backupbucket
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+)
+
+// maxDeleteObjects is the maximum number of objects which can be deleted with a single DeleteObjects request.
+const maxDeleteObjects = 1000
+
+// replicationResult summarizes a single replication run.
+type replicationResult struct {
+	// copied is the number of objects copied to the target bucket.
+	copied int32
+	// deleted is the number of objects deleted from the target bucket as they no longer exist in the source bucket.
+	deleted int32
+	// pending is the number of objects which could not be copied to the target bucket.
+	pending int32
+	// oldestPending is the modification time of the oldest object which could not be copied.
+	oldestPending *time.Time
+}
+
+// replicateObjects copies all objects of the source bucket which are missing in the target bucket or differ in size.
+// Objects which fail to be copied are counted as pending, the remaining objects are still processed. Objects of the
+// target bucket which no longer exist in the source bucket, e.g. because they were garbage collected, are deleted
+// unless the source bucket is empty.
+func replicateObjects(ctx context.Context, source, target S3Client, sourceBucket, targetBucket string) (*replicationResult, error) {
+	replicated := map[string]int64{}
+	targetPaginator := s3.NewListObjectsV2Paginator(target, &s3.ListObjectsV2Input{Bucket: aws.String(targetBucket)})
+	for targetPaginator.HasMorePages() {
+		output, err := targetPaginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list objects of bucket %s: %w", targetBucket, err)
+		}
+		for _, object := range output.Contents {
+			replicated[aws.ToString(object.Key)] = aws.ToInt64(object.Size)
+		}
+	}
+
+	var (
+		result        = &replicationResult{}
+		errs          []error
+		sourceObjects int
+		sources       = s3.NewListObjectsV2Paginator(source, &s3.ListObjectsV2Input{Bucket: aws.String(sourceBucket)})
+	)
+	for sources.HasMorePages() {
+		output, err := sources.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list objects of bucket %s: %w", sourceBucket, err)
+		}
+		for _, object := range output.Contents {
+			key := aws.ToString(object.Key)
+			size, ok := replicated[key]
+			delete(replicated, key)
+			sourceObjects++
+			if ok && size == aws.ToInt64(object.Size) {
+				continue
+			}
+
+			if err := copyObject(ctx, source, target, sourceBucket, targetBucket, key); err != nil {
+				errs = append(errs, err)
+				result.pending++
+				if object.LastModified != nil && (result.oldestPending == nil || object.LastModified.Before(*result.oldestPending)) {
+					result.oldestPending = object.LastModified
+				}
+				continue
+			}
+			result.copied++
+		}
+	}
+
+	// An empty source bucket is rather caused by a lost or misconfigured primary bucket than by the garbage
+	// collection, hence the replica is kept in this case.
+	if sourceObjects > 0 && len(replicated) > 0 {
+		deleted, err := deleteObjects(ctx, target, targetBucket, slices.Sorted(maps.Keys(replicated)))
+		if err != nil {
+			errs = append(errs, err)
+		}
+		result.deleted = deleted
+	}
+
+	return result, errors.Join(errs...)
+}
+
+func deleteObjects(ctx context.Context, target S3Client, targetBucket string, keys []string) (int32, error) {
+	var deleted int32
+	for chunk := range slices.Chunk(keys, maxDeleteObjects) {
+		objects := make([]types.ObjectIdentifier, 0, len(chunk))
+		for _, key := range chunk {
+			objects = append(objects, types.ObjectIdentifier{Key: aws.String(key)})
+		}
+
+		output, err := target.DeleteObjects(ctx, &s3.DeleteObjectsInput{
+			Bucket: aws.String(targetBucket),
+			Delete: &types.Delete{
+				Objects: objects,
+				Quiet:   aws.Bool(true),
+			},
+		})
+		if err != nil {
+			return deleted, fmt.Errorf("failed to delete objects from bucket %s: %w", targetBucket, err)
+		}
+		deleted += int32(len(objects) - len(output.Errors))
+		if len(output.Errors) > 0 {
+			return deleted, fmt.Errorf("failed to delete %d objects from bucket %s: %s", len(output.Errors), targetBucket, aws.ToString(output.Errors[0].Message))
+		}
+	}
+	return deleted, nil
+}
+
+func copyObject(ctx context.Context, source, target S3Client, sourceBucket, targetBucket, key string) error {
+	object, err := source.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(sourceBucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get object %s from bucket %s: %w", key, sourceBucket, err)
+	}
+	defer object.Body.Close()
+
+	if _, err := target.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:        aws.String(targetBucket),
+		Key:           aws.String(key),
+		Body:          object.Body,
+		ContentLength: object.ContentLength,
+		ContentType:   object.ContentType,
+	}); err != nil {
+		return fmt.Errorf("failed to put object %s into bucket %s: %w", key, targetBucket, err)
+	}
+	return nil
+}
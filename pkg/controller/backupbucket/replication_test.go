@@ -0,0 +1,133 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package backupbucket
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("Backupbucket Replication", func() {
+	var (
+		ctrl   *gomock.Controller
+		source *MockS3Client
+		target *MockS3Client
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		source = NewMockS3Client(ctrl)
+		target = NewMockS3Client(ctrl)
+	})
+
+	It("should copy missing and changed objects to the target bucket", func(ctx SpecContext) {
+		target.EXPECT().ListObjectsV2(ctx, &s3.ListObjectsV2Input{Bucket: aws.String("backup-replica")}, gomock.Any()).Return(&s3.ListObjectsV2Output{
+			Contents: []types.Object{
+				{Key: aws.String("shoot/full-snapshot"), Size: aws.Int64(3)},
+				{Key: aws.String("shoot/delta-snapshot-1"), Size: aws.Int64(1)},
+			},
+		}, nil)
+		source.EXPECT().ListObjectsV2(ctx, &s3.ListObjectsV2Input{Bucket: aws.String("backup")}, gomock.Any()).Return(&s3.ListObjectsV2Output{
+			Contents: []types.Object{
+				{Key: aws.String("shoot/full-snapshot"), Size: aws.Int64(3)},
+				{Key: aws.String("shoot/delta-snapshot-1"), Size: aws.Int64(2)},
+				{Key: aws.String("shoot/delta-snapshot-2"), Size: aws.Int64(2)},
+			},
+		}, nil)
+
+		for _, key := range []string{"shoot/delta-snapshot-1", "shoot/delta-snapshot-2"} {
+			source.EXPECT().GetObject(ctx, &s3.GetObjectInput{Bucket: aws.String("backup"), Key: aws.String(key)}).Return(&s3.GetObjectOutput{
+				Body:          io.NopCloser(strings.NewReader("ab")),
+				ContentLength: aws.Int64(2),
+			}, nil)
+			target.EXPECT().PutObject(ctx, gomock.Any()).DoAndReturn(func(_ any, in *s3.PutObjectInput, _ ...func(*s3.Options)) (*s3.PutObjectOutput, error) {
+				Expect(in.Bucket).To(Equal(aws.String("backup-replica")))
+				Expect(in.Key).To(Equal(aws.String(key)))
+				Expect(in.ContentLength).To(Equal(aws.Int64(2)))
+				return &s3.PutObjectOutput{}, nil
+			})
+		}
+
+		result, err := replicateObjects(ctx, source, target, "backup", "backup-replica")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(&replicationResult{copied: 2}))
+	})
+
+	It("should report objects which could not be copied as pending", func(ctx SpecContext) {
+		older := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		newer := older.Add(time.Hour)
+
+		target.EXPECT().ListObjectsV2(ctx, gomock.Any(), gomock.Any()).Return(&s3.ListObjectsV2Output{}, nil)
+		source.EXPECT().ListObjectsV2(ctx, gomock.Any(), gomock.Any()).Return(&s3.ListObjectsV2Output{
+			Contents: []types.Object{
+				{Key: aws.String("shoot/delta-snapshot-2"), Size: aws.Int64(1), LastModified: &newer},
+				{Key: aws.String("shoot/delta-snapshot-1"), Size: aws.Int64(1), LastModified: &older},
+			},
+		}, nil)
+		source.EXPECT().GetObject(ctx, gomock.Any()).Return(nil, errors.New("unavailable")).Times(2)
+
+		result, err := replicateObjects(ctx, source, target, "backup", "backup-replica")
+		Expect(err).To(MatchError(ContainSubstring("unavailable")))
+		Expect(result).To(Equal(&replicationResult{pending: 2, oldestPending: &older}))
+	})
+
+	It("should delete objects which no longer exist in the source bucket", func(ctx SpecContext) {
+		target.EXPECT().ListObjectsV2(ctx, gomock.Any(), gomock.Any()).Return(&s3.ListObjectsV2Output{
+			Contents: []types.Object{
+				{Key: aws.String("shoot/full-snapshot-2"), Size: aws.Int64(3)},
+				{Key: aws.String("shoot/full-snapshot-1"), Size: aws.Int64(3)},
+				{Key: aws.String("shoot/delta-snapshot-1"), Size: aws.Int64(1)},
+			},
+		}, nil)
+		source.EXPECT().ListObjectsV2(ctx, gomock.Any(), gomock.Any()).Return(&s3.ListObjectsV2Output{
+			Contents: []types.Object{
+				{Key: aws.String("shoot/full-snapshot-2"), Size: aws.Int64(3)},
+			},
+		}, nil)
+		target.EXPECT().DeleteObjects(ctx, &s3.DeleteObjectsInput{
+			Bucket: aws.String("backup-replica"),
+			Delete: &types.Delete{
+				Objects: []types.ObjectIdentifier{
+					{Key: aws.String("shoot/delta-snapshot-1")},
+					{Key: aws.String("shoot/full-snapshot-1")},
+				},
+				Quiet: aws.Bool(true),
+			},
+		}).Return(&s3.DeleteObjectsOutput{}, nil)
+
+		result, err := replicateObjects(ctx, source, target, "backup", "backup-replica")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(&replicationResult{deleted: 2}))
+	})
+
+	It("should keep the target bucket if the source bucket is empty", func(ctx SpecContext) {
+		target.EXPECT().ListObjectsV2(ctx, gomock.Any(), gomock.Any()).Return(&s3.ListObjectsV2Output{
+			Contents: []types.Object{
+				{Key: aws.String("shoot/full-snapshot"), Size: aws.Int64(3)},
+			},
+		}, nil)
+		source.EXPECT().ListObjectsV2(ctx, gomock.Any(), gomock.Any()).Return(&s3.ListObjectsV2Output{}, nil)
+
+		result, err := replicateObjects(ctx, source, target, "backup", "backup-replica")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(&replicationResult{}))
+	})
+
+	It("should fail if the target bucket cannot be listed", func(ctx SpecContext) {
+		target.EXPECT().ListObjectsV2(ctx, gomock.Any(), gomock.Any()).Return(nil, errors.New("forbidden"))
+
+		result, err := replicateObjects(ctx, source, target, "backup", "backup-replica")
+		Expect(err).To(MatchError(ContainSubstring("forbidden")))
+		Expect(result).To(BeNil())
+	})
+})
@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package backupbucket
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/apis/ironcore/helper"
+	"github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/ironcore"
+)
+
+const defaultReplicationInterval = 5 * time.Minute
+
+// replicator periodically copies new backup objects of all BackupBuckets with a configured replication
+// into their secondary bucket and deletes the objects which no longer exist in the primary bucket.
+type replicator struct {
+	client   client.Client
+	log      logr.Logger
+	interval time.Duration
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable.
+func (r *replicator) NeedLeaderElection() bool {
+	return true
+}
+
+// Start implements manager.Runnable.
+func (r *replicator) Start(ctx context.Context) error {
+	wait.UntilWithContext(ctx, r.replicateAll, r.interval)
+	return nil
+}
+
+func (r *replicator) replicateAll(ctx context.Context) {
+	backupBucketList := &extensionsv1alpha1.BackupBucketList{}
+	if err := r.client.List(ctx, backupBucketList); err != nil {
+		r.log.Error(err, "Failed to list BackupBuckets")
+		return
+	}
+
+	for i := range backupBucketList.Items {
+		backupBucket := &backupBucketList.Items[i]
+		if backupBucket.Spec.Type != ironcore.Type || backupBucket.DeletionTimestamp != nil || backupBucket.Status.GeneratedSecretRef == nil {
+			continue
+		}
+
+		log := r.log.WithValues("backupbucket", client.ObjectKeyFromObject(backupBucket))
+		if err := r.replicate(ctx, log, backupBucket); err != nil {
+			log.Error(err, "Failed to replicate BackupBucket")
+		}
+	}
+}
+
+func (r *replicator) replicate(ctx context.Context, log logr.Logger, backupBucket *extensionsv1alpha1.BackupBucket) error {
+	status, err := helper.BackupBucketStatusFromRaw(backupBucket.Status.ProviderStatus)
+	if err != nil {
+		return fmt.Errorf("failed to decode provider status: %w", err)
+	}
+	if status.Replication == nil {
+		return nil
+	}
+
+	secret := &corev1.Secret{}
+	if err := r.client.Get(ctx, client.ObjectKey{Namespace: backupBucket.Status.GeneratedSecretRef.Namespace, Name: backupBucket.Status.GeneratedSecretRef.Name}, secret); err != nil {
+		return fmt.Errorf("failed to get backup bucket generated secret: %w", err)
+	}
+
+	source, err := s3ClientFromSecret(ctx, secret, ironcore.AccessKeyID, ironcore.SecretAccessKey, ironcore.Endpoint)
+	if err != nil {
+		return fmt.Errorf("failed to create s3 client for primary bucket: %w", err)
+	}
+	target, err := s3ClientFromSecret(ctx, secret, ironcore.ReplicaAccessKeyID, ironcore.ReplicaSecretAccessKey, ironcore.ReplicaEndpoint)
+	if err != nil {
+		return fmt.Errorf("failed to create s3 client for secondary bucket: %w", err)
+	}
+
+	log.V(2).Info("Replicating BackupBucket", "targetBucket", status.Replication.BucketName)
+	result, replicationErr := replicateObjects(ctx, source, target, backupBucket.Name, status.Replication.BucketName)
+	if result == nil {
+		return replicationErr
+	}
+
+	now := metav1.Now()
+	status.Replication.LastReplicationTime = &now
+	status.Replication.PendingObjects = result.pending
+	status.Replication.Lag = &metav1.Duration{}
+	if result.oldestPending != nil {
+		status.Replication.Lag.Duration = now.Sub(*result.oldestPending).Truncate(time.Second)
+	}
+	if err := patchReplicationStatus(ctx, r.client, backupBucket, status.Replication); err != nil {
+		return fmt.Errorf("failed to patch replication status: %w", err)
+	}
+	log.V(2).Info("Replicated BackupBucket", "copied", result.copied, "deleted", result.deleted, "pending", result.pending)
+
+	return replicationErr
+}
@@ -0,0 +1,37 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package backupbucket
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/ironcore"
+)
+
+//go:generate $MOCKGEN -copyright_file ../../../hack/license-header.txt -package backupbucket -destination=mock_s3_client.go -source s3_client.go Client
+
+// S3Client is the subset of the S3 API used to replicate backups between buckets.
+type S3Client interface {
+	s3.ListObjectsV2APIClient
+	GetObject(ctx context.Context, params *s3.GetObjectInput, optFns ...func(*s3.Options)) (*s3.GetObjectOutput, error)
+	PutObject(ctx context.Context, params *s3.PutObjectInput, optFns ...func(*s3.Options)) (*s3.PutObjectOutput, error)
+	DeleteObjects(ctx context.Context, params *s3.DeleteObjectsInput, optFns ...func(*s3.Options)) (*s3.DeleteObjectsOutput, error)
+}
+
+// s3ClientFromSecret creates an S3Client for the endpoint and credentials stored under the given keys of the secret.
+func s3ClientFromSecret(ctx context.Context, secret *corev1.Secret, accessKeyIDKey, secretAccessKeyKey, endpointKey string) (S3Client, error) {
+	cfg, err := ironcore.S3ConfigFromSecret(ctx, secret, accessKeyIDKey, secretAccessKeyKey, endpointKey)
+	if err != nil {
+		return nil, err
+	}
+	return NewS3ClientFromConfig(cfg), nil
+}
+
+var NewS3ClientFromConfig = func(cfg aws.Config, optFns ...func(*s3.Options)) S3Client {
+	return s3.NewFromConfig(cfg, optFns...)
+}
@@ -47,5 +47,19 @@ func (a *actuator) Delete(ctx context.Context, log logr.Logger, backupEntry *ext
 		return fmt.Errorf("failed to get s3 client from s3 client secret: %w", err)
 	}
 
-	return DeleteObjectsWithPrefix(ctx, s3Client, backupEntry.Spec.BucketName, fmt.Sprintf("%s/", backupEntry.Name))
+	prefix := fmt.Sprintf("%s/", backupEntry.Name)
+	if err := DeleteObjectsWithPrefix(ctx, s3Client, backupEntry.Spec.BucketName, prefix); err != nil {
+		return err
+	}
+
+	// also delete the copy in the secondary bucket if the backups are replicated
+	replicaS3Client, replicaBucketName, err := GetReplicaS3ClientFromS3ClientSecret(ctx, s3ClientSecret)
+	if err != nil {
+		return fmt.Errorf("failed to get replica s3 client from s3 client secret: %w", err)
+	}
+	if replicaS3Client == nil {
+		return nil
+	}
+	log.V(2).Info("Deleting replicated backups", "bucket", replicaBucketName)
+	return DeleteObjectsWithPrefix(ctx, replicaS3Client, replicaBucketName, prefix)
 }
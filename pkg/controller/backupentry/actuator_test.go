@@ -139,4 +139,57 @@ var _ = Describe("BackupEntry Delete", func() {
 		Expect(a.Delete(ctx, log, backupEntry)).Should(Succeed())
 	})
 
+	It("should delete the BackupEntry in the primary and the replica bucket", func(ctx SpecContext) {
+		By("creating a secret with credentials data for the primary and the replica bucket")
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns.Name,
+				Name:      "test-replica-secret",
+			},
+			Data: map[string][]byte{
+				"accessKeyID":            []byte("test-access-key"),
+				"secretAccessKey":        []byte("test-secret-access-key"),
+				"endpoint":               []byte("endpoint-efef-ihfbd-ssadd.storage"),
+				"replicaBucketName":      []byte("test-bucket-replica"),
+				"replicaAccessKeyID":     []byte("test-replica-access-key"),
+				"replicaSecretAccessKey": []byte("test-replica-secret-access-key"),
+				"replicaEndpoint":        []byte("endpoint-replica.storage"),
+			},
+		}
+		Expect(k8sClient.Create(ctx, secret)).To(Succeed())
+		DeferCleanup(k8sClient.Delete, secret)
+
+		backupEntry := &extensionsv1alpha1.BackupEntry{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns.Name,
+				Name:      "test-replicated-backup-entry",
+			},
+			Spec: extensionsv1alpha1.BackupEntrySpec{
+				Region:     "foo",
+				BucketName: "test-bucket",
+				SecretRef: corev1.SecretReference{
+					Name:      secret.Name,
+					Namespace: ns.Name,
+				},
+			},
+		}
+
+		for _, bucketName := range []string{"test-bucket", "test-bucket-replica"} {
+			key := aws.String(fmt.Sprintf("%s/test-obj", backupEntry.Name))
+			mockS3Client.EXPECT().ListObjectsV2(ctx, &s3.ListObjectsV2Input{
+				Bucket: aws.String(bucketName),
+				Prefix: aws.String(fmt.Sprintf("%s/", backupEntry.Name)),
+			}, gomock.Any()).Return(&s3.ListObjectsV2Output{Contents: []types.Object{{Key: key}}}, nil)
+			mockS3Client.EXPECT().DeleteObjects(ctx, &s3.DeleteObjectsInput{
+				Bucket: aws.String(bucketName),
+				Delete: &types.Delete{
+					Objects: []types.ObjectIdentifier{{Key: key}},
+					Quiet:   aws.Bool(true),
+				},
+			}).Return(&s3.DeleteObjectsOutput{}, nil)
+		}
+
+		By("deleting the BackupEntry")
+		Expect(a.Delete(ctx, log, backupEntry)).Should(Succeed())
+	})
 })
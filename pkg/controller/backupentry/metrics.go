@@ -0,0 +1,43 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package backupentry
+
+import (
+	"context"
+
+	"github.com/gardener/gardener/extensions/pkg/controller/backupentry"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+
+	"github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/metrics"
+)
+
+// metricsActuator records the duration and the result of the operations of the wrapped backupentry actuator.
+type metricsActuator struct {
+	backupentry.Actuator
+}
+
+func (a *metricsActuator) Reconcile(ctx context.Context, log logr.Logger, backupEntry *extensionsv1alpha1.BackupEntry) error {
+	return metrics.ObserveActuator(backupentry.ControllerName, metrics.OperationReconcile, func() error {
+		return a.Actuator.Reconcile(ctx, log, backupEntry)
+	})
+}
+
+func (a *metricsActuator) Delete(ctx context.Context, log logr.Logger, backupEntry *extensionsv1alpha1.BackupEntry) error {
+	return metrics.ObserveActuator(backupentry.ControllerName, metrics.OperationDelete, func() error {
+		return a.Actuator.Delete(ctx, log, backupEntry)
+	})
+}
+
+func (a *metricsActuator) Restore(ctx context.Context, log logr.Logger, backupEntry *extensionsv1alpha1.BackupEntry) error {
+	return metrics.ObserveActuator(backupentry.ControllerName, metrics.OperationRestore, func() error {
+		return a.Actuator.Restore(ctx, log, backupEntry)
+	})
+}
+
+func (a *metricsActuator) Migrate(ctx context.Context, log logr.Logger, backupEntry *extensionsv1alpha1.BackupEntry) error {
+	return metrics.ObserveActuator(backupentry.ControllerName, metrics.OperationMigrate, func() error {
+		return a.Actuator.Migrate(ctx, log, backupEntry)
+	})
+}
@@ -5,11 +5,8 @@ package backupentry
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
-	"github.com/aws/aws-sdk-go-v2/config"
-	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	corev1 "k8s.io/api/core/v1"
 
@@ -26,35 +23,31 @@ type S3Client interface {
 // GetS3ClientFromS3ClientSecret creates s3Client from bucket access key ID
 // and secret access key.
 func GetS3ClientFromS3ClientSecret(ctx context.Context, secret *corev1.Secret) (S3Client, error) {
-	if secret.Data == nil {
-		return nil, fmt.Errorf("secret does not contain any data")
-	}
-
-	accessKeyID, ok := secret.Data[ironcore.AccessKeyID]
-	if !ok {
-		return nil, fmt.Errorf("missing %q field in secret", ironcore.AccessKeyID)
-	}
+	return getS3ClientFromSecret(ctx, secret, ironcore.AccessKeyID, ironcore.SecretAccessKey, ironcore.Endpoint)
+}
 
-	secretAccessKey, ok := secret.Data[ironcore.SecretAccessKey]
+// GetReplicaS3ClientFromS3ClientSecret creates an s3Client for the secondary bucket the backups
+// are replicated to and returns it together with the name of the secondary bucket. If the secret does
+// not contain a secondary bucket, nil is returned.
+func GetReplicaS3ClientFromS3ClientSecret(ctx context.Context, secret *corev1.Secret) (S3Client, string, error) {
+	bucketName, ok := secret.Data[ironcore.ReplicaBucketName]
 	if !ok {
-		return nil, fmt.Errorf("missing %q field in secret", ironcore.SecretAccessKey)
+		return nil, "", nil
 	}
 
-	endpoint, ok := secret.Data[ironcore.Endpoint]
-	if !ok {
-		return nil, fmt.Errorf("missing %q field in secret", ironcore.Endpoint)
+	s3Client, err := getS3ClientFromSecret(ctx, secret, ironcore.ReplicaAccessKeyID, ironcore.ReplicaSecretAccessKey, ironcore.ReplicaEndpoint)
+	if err != nil {
+		return nil, "", err
 	}
+	return s3Client, string(bucketName), nil
+}
 
-	awsCredentials := credentials.NewStaticCredentialsProvider(string(accessKeyID), string(secretAccessKey), "")
-	endpointStr := string(endpoint)
-
-	cfg, err := config.LoadDefaultConfig(ctx, config.WithCredentialsProvider(awsCredentials), config.WithBaseEndpoint(endpointStr))
+func getS3ClientFromSecret(ctx context.Context, secret *corev1.Secret, accessKeyIDKey, secretAccessKeyKey, endpointKey string) (S3Client, error) {
+	cfg, err := ironcore.S3ConfigFromSecret(ctx, secret, accessKeyIDKey, secretAccessKeyKey, endpointKey)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create AWS config: %w", err)
+		return nil, err
 	}
-	s3Client := NewS3ClientFromConfig(cfg)
-
-	return s3Client, nil
+	return NewS3ClientFromConfig(cfg), nil
 }
 
 var NewS3ClientFromConfig = func(cfg aws.Config, optFns ...func(*s3.Options)) S3Client {
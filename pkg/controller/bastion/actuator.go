@@ -5,14 +5,17 @@ package bastion
 
 import (
 	"github.com/gardener/gardener/extensions/pkg/controller/bastion"
+	"k8s.io/client-go/tools/events"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	controllerconfig "github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/apis/config"
+	"github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/ironcore"
 )
 
 type actuator struct {
 	client        client.Client
+	recorder      events.EventRecorder
 	bastionConfig *controllerconfig.BastionConfig
 }
 
@@ -20,6 +23,7 @@ type actuator struct {
 func NewActuator(mgr manager.Manager, bastionConfig *controllerconfig.BastionConfig) bastion.Actuator {
 	return &actuator{
 		client:        mgr.GetClient(),
+		recorder:      mgr.GetEventRecorder(ironcore.ProviderName),
 		bastionConfig: bastionConfig,
 	}
 }
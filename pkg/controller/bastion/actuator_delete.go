@@ -36,7 +36,7 @@ func (a *actuator) Delete(ctx context.Context, log logr.Logger, bastion *extensi
 			Name:      bastionHostName,
 		},
 	}
-	if err := ironcoreClient.Delete(ctx, bastionHost); err != nil {
+	if err := ironcore.DeleteAndRecord(ctx, ironcoreClient, a.recorder, bastion, bastionHost); err != nil {
 		if apierrors.IsNotFound(err) {
 			log.V(2).Info("Bastion host not found, skipping deletion")
 			return nil
@@ -75,12 +75,12 @@ func (a *actuator) reconcile(ctx context.Context, log logr.Logger, bastion *exte
 		return fmt.Errorf("failed to get ironcore client and namespace from cloudprovider secret: %w", err)
 	}
 
-	machine, err := a.applyMachineAndIgnitionSecret(ctx, namespace, ironcoreClient, infraStatus, opt)
+	machine, err := a.applyMachineAndIgnitionSecret(ctx, bastion, namespace, ironcoreClient, infraStatus, opt)
 	if err != nil {
 		return fmt.Errorf("failed to create machine: %w", err)
 	}
 
-	if err = ensureNetworkPolicy(ctx, namespace, bastion, ironcoreClient, infraStatus, machine); err != nil {
+	if err = a.ensureNetworkPolicy(ctx, namespace, bastion, ironcoreClient, infraStatus, machine); err != nil {
 		return fmt.Errorf("failed to create network policy: %w", err)
 	}
 
@@ -147,7 +147,7 @@ func getMachineEndpoints(ctx context.Context, machine *computev1alpha1.Machine,
 // bastion host machine. It first sets the owner reference for the ignition
 // secret to the bastion host machine, to ensure that the secret is garbage
 // collected when the bastion host is deleted.
-func (a *actuator) applyMachineAndIgnitionSecret(ctx context.Context, namespace string, ironcoreClient client.Client, infraStatus *api.InfrastructureStatus, opt *Options) (*computev1alpha1.Machine, error) {
+func (a *actuator) applyMachineAndIgnitionSecret(ctx context.Context, bastion *extensionsv1alpha1.Bastion, namespace string, ironcoreClient client.Client, infraStatus *api.InfrastructureStatus, opt *Options) (*computev1alpha1.Machine, error) {
 	ignitionSecret, err := generateIgnitionSecret(namespace, opt)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create ignition secret: %w", err)
@@ -155,17 +155,21 @@ func (a *actuator) applyMachineAndIgnitionSecret(ctx context.Context, namespace
 
 	bastionHost := generateMachine(namespace, a.bastionConfig, infraStatus, opt.BastionInstanceName, ignitionSecret.Name)
 
-	if _, err = controllerutil.CreateOrPatch(ctx, ironcoreClient, bastionHost, nil); err != nil {
+	result, err := controllerutil.CreateOrPatch(ctx, ironcoreClient, bastionHost, nil)
+	if err != nil {
 		return nil, fmt.Errorf("failed to create or patch bastion host machine %s: %w", client.ObjectKeyFromObject(bastionHost), err)
 	}
+	ironcore.RecordApplyEvent(a.recorder, bastion, bastionHost, result)
 
 	if err := controllerutil.SetOwnerReference(bastionHost, ignitionSecret, ironcoreClient.Scheme()); err != nil {
 		return nil, fmt.Errorf("failed to set owner reference for ignition secret %s: %w", client.ObjectKeyFromObject(ignitionSecret), err)
 	}
 
-	if _, err = controllerutil.CreateOrPatch(ctx, ironcoreClient, ignitionSecret, nil); err != nil {
+	result, err = controllerutil.CreateOrPatch(ctx, ironcoreClient, ignitionSecret, nil)
+	if err != nil {
 		return nil, fmt.Errorf("failed to create or patch ignition secret %s for bastion host %s: %w", client.ObjectKeyFromObject(ignitionSecret), client.ObjectKeyFromObject(bastionHost), err)
 	}
+	ironcore.RecordApplyEvent(a.recorder, bastion, ignitionSecret, result)
 
 	return bastionHost, nil
 }
@@ -322,7 +326,7 @@ func IngressReady(ingress *corev1.LoadBalancerIngress) bool {
 	return ingress != nil && (ingress.Hostname != "" || ingress.IP != "")
 }
 
-func ensureNetworkPolicy(ctx context.Context, namespace string, bastion *extensionsv1alpha1.Bastion, ironcoreClient client.Client, infraStatus *api.InfrastructureStatus, bastionHost *computev1alpha1.Machine) error {
+func (a *actuator) ensureNetworkPolicy(ctx context.Context, namespace string, bastion *extensionsv1alpha1.Bastion, ironcoreClient client.Client, infraStatus *api.InfrastructureStatus, bastionHost *computev1alpha1.Machine) error {
 	cidrs, err := getBastionIngressCIDR(bastion)
 	if err != nil {
 		return fmt.Errorf("failed to get CIDR from bastion ingress: %w", err)
@@ -371,11 +375,13 @@ func ensureNetworkPolicy(ctx context.Context, namespace string, bastion *extensi
 		return fmt.Errorf("failed to set owner reference for network policy %s: %w", client.ObjectKeyFromObject(networkPolicy), err)
 	}
 
-	if _, err = controllerutil.CreateOrPatch(ctx, ironcoreClient, networkPolicy, nil); err != nil {
+	result, err := controllerutil.CreateOrPatch(ctx, ironcoreClient, networkPolicy, nil)
+	if err != nil {
 		return fmt.Errorf("failed to create or patch network policy %s: %w", client.ObjectKeyFromObject(networkPolicy), err)
 	}
+	ironcore.RecordApplyEvent(a.recorder, bastion, networkPolicy, result)
 
-	return err
+	return nil
 }
 
 func getBastionIngressCIDR(bastion *extensionsv1alpha1.Bastion) ([]string, error) {
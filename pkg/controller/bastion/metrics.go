@@ -0,0 +1,38 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package bastion
+
+import (
+	"context"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	"github.com/gardener/gardener/extensions/pkg/controller/bastion"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+
+	"github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/metrics"
+)
+
+// metricsActuator records the duration and the result of the operations of the wrapped bastion actuator.
+type metricsActuator struct {
+	bastion.Actuator
+}
+
+func (a *metricsActuator) Reconcile(ctx context.Context, log logr.Logger, b *extensionsv1alpha1.Bastion, cluster *extensionscontroller.Cluster) error {
+	return metrics.ObserveActuator(bastion.ControllerName, metrics.OperationReconcile, func() error {
+		return a.Actuator.Reconcile(ctx, log, b, cluster)
+	})
+}
+
+func (a *metricsActuator) Delete(ctx context.Context, log logr.Logger, b *extensionsv1alpha1.Bastion, cluster *extensionscontroller.Cluster) error {
+	return metrics.ObserveActuator(bastion.ControllerName, metrics.OperationDelete, func() error {
+		return a.Actuator.Delete(ctx, log, b, cluster)
+	})
+}
+
+func (a *metricsActuator) ForceDelete(ctx context.Context, log logr.Logger, b *extensionsv1alpha1.Bastion, cluster *extensionscontroller.Cluster) error {
+	return metrics.ObserveActuator(bastion.ControllerName, metrics.OperationForceDelete, func() error {
+		return a.Actuator.ForceDelete(ctx, log, b, cluster)
+	})
+}
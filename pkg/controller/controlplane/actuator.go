@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	"github.com/gardener/gardener/extensions/pkg/controller/controlplane"
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// actuator wraps the generic controlplane actuator and reports the VolumeClasses referenced by StorageClasses which
+// could not be found as condition on the ControlPlane.
+type actuator struct {
+	controlplane.Actuator
+
+	client         client.Client
+	clock          clock.Clock
+	valuesProvider *valuesProvider
+}
+
+func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, cp *extensionsv1alpha1.ControlPlane, cluster *extensionscontroller.Cluster) (bool, error) {
+	requeue, err := a.Actuator.Reconcile(ctx, log, cp, cluster)
+	if err != nil {
+		return requeue, err
+	}
+
+	// The missing VolumeClasses are recorded when the generic actuator computes the StorageClass chart values.
+	missingVolumeClasses, ok := a.valuesProvider.popMissingVolumeClasses(cp)
+	if !ok {
+		return requeue, nil
+	}
+	return requeue, a.updateVolumeClassesCondition(ctx, cp, missingVolumeClasses)
+}
+
+// updateVolumeClassesCondition reports the given VolumeClasses which could not be found as condition on the
+// ControlPlane.
+func (a *actuator) updateVolumeClassesCondition(ctx context.Context, cp *extensionsv1alpha1.ControlPlane, missingVolumeClasses []string) error {
+	condition := v1beta1helper.GetOrInitConditionWithClock(a.clock, cp.Status.Conditions, ConditionTypeVolumeClassesAvailable)
+	if len(missingVolumeClasses) == 0 {
+		condition = v1beta1helper.UpdatedConditionWithClock(a.clock, condition, gardencorev1beta1.ConditionTrue, "VolumeClassesAvailable", "All VolumeClasses referenced by StorageClasses are available.")
+	} else {
+		slices.Sort(missingVolumeClasses)
+		condition = v1beta1helper.UpdatedConditionWithClock(a.clock, condition, gardencorev1beta1.ConditionFalse, "VolumeClassesMissing",
+			fmt.Sprintf("The StorageClasses for the following VolumeClasses were not deployed as the VolumeClasses could not be found: %s", strings.Join(slices.Compact(missingVolumeClasses), ", ")))
+	}
+
+	base := cp.DeepCopy()
+	cp.Status.Conditions = v1beta1helper.MergeConditions(cp.Status.Conditions, condition)
+	if equality.Semantic.DeepEqual(base.Status.Conditions, cp.Status.Conditions) {
+		return nil
+	}
+	if err := a.client.Status().Patch(ctx, cp, client.MergeFrom(base)); err != nil {
+		return fmt.Errorf("failed to update condition %s of controlplane %s: %w", ConditionTypeVolumeClassesAvailable, client.ObjectKeyFromObject(cp), err)
+	}
+	return nil
+}
@@ -0,0 +1,158 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/gardener/gardener/extensions/pkg/controller"
+	mockcontrolplane "github.com/gardener/gardener/extensions/pkg/controller/controlplane/mock"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	corev1alpha1 "github.com/ironcore-dev/ironcore/api/core/v1alpha1"
+	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	apisironcore "github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/apis/ironcore"
+	"github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/ironcore"
+)
+
+var errFakeReconcile = errors.New("fake reconcile error")
+
+var _ = Describe("Actuator", func() {
+	ns, vp, _ := SetupTest()
+
+	var (
+		genericActuator *mockcontrolplane.MockActuator
+		fakeClock       *testclock.FakeClock
+		a               *actuator
+		cp              *extensionsv1alpha1.ControlPlane
+		cluster         *controller.Cluster
+	)
+
+	BeforeEach(func(ctx SpecContext) {
+		genericActuator = mockcontrolplane.NewMockActuator(gomock.NewController(GinkgoT()))
+		fakeClock = testclock.NewFakeClock(time.Date(2026, 10, 18, 8, 0, 0, 0, time.UTC))
+		a = &actuator{
+			Actuator:       genericActuator,
+			client:         k8sClient,
+			clock:          fakeClock,
+			valuesProvider: vp,
+		}
+
+		cp = &extensionsv1alpha1.ControlPlane{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "control-plane-",
+			},
+			Spec: extensionsv1alpha1.ControlPlaneSpec{
+				DefaultSpec: extensionsv1alpha1.DefaultSpec{
+					Type: ironcore.Type,
+				},
+				SecretRef: corev1.SecretReference{
+					Name:      "my-infra-creds",
+					Namespace: ns.Name,
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, cp)).To(Succeed())
+		DeferCleanup(k8sClient.Delete, cp)
+
+		cloudProfileConfig, err := json.Marshal(&apisironcore.CloudProfileConfig{
+			StorageClasses: apisironcore.StorageClasses{
+				Default: &apisironcore.StorageClass{
+					Name: "foo",
+					Type: "volume-delayed",
+				},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		cluster = &controller.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: ns.Name,
+			},
+			CloudProfile: &gardencorev1beta1.CloudProfile{
+				Spec: gardencorev1beta1.CloudProfileSpec{
+					ProviderConfig: &runtime.RawExtension{Raw: cloudProfileConfig},
+				},
+			},
+		}
+	})
+
+	It("should report missing volumeClasses in a condition after the reconciliation", func(ctx SpecContext) {
+		genericActuator.EXPECT().Reconcile(ctx, gomock.Any(), cp, cluster).DoAndReturn(
+			func(ctx context.Context, _ logr.Logger, cp *extensionsv1alpha1.ControlPlane, cluster *controller.Cluster) (bool, error) {
+				_, err := vp.GetStorageClassesChartValues(ctx, cp, cluster)
+				return false, err
+			}).Times(2)
+
+		By("reconciling the controlplane without the volumeClass of the storage class")
+		Expect(a.Reconcile(ctx, GinkgoLogr, cp, cluster)).To(BeFalse())
+
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(cp), cp)).To(Succeed())
+		Expect(cp.Status.Conditions).To(ConsistOf(SatisfyAll(
+			HaveField("Type", ConditionTypeVolumeClassesAvailable),
+			HaveField("Status", gardencorev1beta1.ConditionFalse),
+			HaveField("Reason", "VolumeClassesMissing"),
+			HaveField("Message", ContainSubstring("volume-delayed")),
+			HaveField("LastTransitionTime.Time", BeTemporally("==", fakeClock.Now())),
+		)))
+
+		By("creating the volumeClass")
+		volumeClass := &storagev1alpha1.VolumeClass{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "volume-delayed",
+			},
+			Capabilities: corev1alpha1.ResourceList{
+				corev1alpha1.ResourceIOPS: resource.MustParse("100"),
+				corev1alpha1.ResourceTPS:  resource.MustParse("100"),
+			},
+		}
+		Expect(k8sClient.Create(ctx, volumeClass)).To(Succeed())
+		DeferCleanup(k8sClient.Delete, volumeClass)
+
+		By("reconciling the controlplane again")
+		fakeClock.Step(time.Minute)
+		Expect(a.Reconcile(ctx, GinkgoLogr, cp, cluster)).To(BeFalse())
+
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(cp), cp)).To(Succeed())
+		Expect(cp.Status.Conditions).To(ConsistOf(SatisfyAll(
+			HaveField("Type", ConditionTypeVolumeClassesAvailable),
+			HaveField("Status", gardencorev1beta1.ConditionTrue),
+			HaveField("Reason", "VolumeClassesAvailable"),
+			HaveField("LastTransitionTime.Time", BeTemporally("==", fakeClock.Now())),
+		)))
+	})
+
+	It("should not update the condition if the storage classes were not computed", func(ctx SpecContext) {
+		genericActuator.EXPECT().Reconcile(ctx, gomock.Any(), cp, cluster).Return(true, nil)
+
+		Expect(a.Reconcile(ctx, GinkgoLogr, cp, cluster)).To(BeTrue())
+
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(cp), cp)).To(Succeed())
+		Expect(cp.Status.Conditions).To(BeEmpty())
+	})
+
+	It("should not update the condition if the reconciliation fails", func(ctx SpecContext) {
+		genericActuator.EXPECT().Reconcile(ctx, gomock.Any(), cp, cluster).Return(false, errFakeReconcile)
+
+		_, err := a.Reconcile(ctx, GinkgoLogr, cp, cluster)
+		Expect(err).To(MatchError(errFakeReconcile))
+
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(cp), cp)).To(Succeed())
+		Expect(cp.Status.Conditions).To(BeEmpty())
+	})
+})
@@ -11,6 +11,7 @@ import (
 	"github.com/gardener/gardener/extensions/pkg/controller/controlplane/genericactuator"
 	"github.com/gardener/gardener/extensions/pkg/util"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
@@ -38,10 +39,11 @@ type AddOptions struct {
 // AddToManagerWithOptions adds a controller with the given Options to the given manager.
 // The opts.Reconciler is being set with a newly instantiated actuator.
 func AddToManagerWithOptions(ctx context.Context, mgr manager.Manager, opts AddOptions) error {
-	actuator, err := genericactuator.NewActuator(mgr, ironcore.ProviderName,
+	valuesProvider := newValuesProvider(mgr)
+	genericActuator, err := genericactuator.NewActuator(mgr, ironcore.ProviderName,
 		secretConfigsFunc, shootAccessSecretsFunc,
-		configChart, controlPlaneChart, controlPlaneShootChart, nil, storageClassChart,
-		NewValuesProvider(mgr), extensionscontroller.ChartRendererFactoryFunc(util.NewChartRendererForShoot),
+		configChart, controlPlaneChart, controlPlaneShootChart, controlPlaneShootCRDsChart, storageClassChart,
+		valuesProvider, extensionscontroller.ChartRendererFactoryFunc(util.NewChartRendererForShoot),
 		imagevector.ImageVector(), ironcore.CloudProviderConfigName, nil, opts.WebhookServerNamespace)
 
 	if err != nil {
@@ -49,7 +51,12 @@ func AddToManagerWithOptions(ctx context.Context, mgr manager.Manager, opts AddO
 	}
 
 	return controlplane.Add(mgr, controlplane.AddArgs{
-		Actuator:          actuator,
+		Actuator: &metricsActuator{Actuator: &actuator{
+			Actuator:       genericActuator,
+			client:         mgr.GetClient(),
+			clock:          clock.RealClock{},
+			valuesProvider: valuesProvider,
+		}},
 		ControllerOptions: opts.Controller,
 		Predicates:        controlplane.DefaultPredicates(ctx, mgr, opts.IgnoreOperationAnnotation),
 		Type:              ironcore.Type,
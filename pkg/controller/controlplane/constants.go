@@ -3,6 +3,10 @@
 
 package controlplane
 
+import (
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
 const (
 	// StorageClassNameKeyName is the name key name of the StorageClass value map
 	StorageClassNameKeyName = "name"
@@ -12,4 +16,22 @@ const (
 	StorageClassDefaultKeyName = "default"
 	// StorageClassExpandableKeyName is the expandable key name of the StorageClass value map
 	StorageClassExpandableKeyName = "expandable"
+	// StorageClassReclaimPolicyKeyName is the reclaim policy key name of the StorageClass value map
+	StorageClassReclaimPolicyKeyName = "reclaimPolicy"
+	// StorageClassVolumeBindingModeKeyName is the volume binding mode key name of the StorageClass value map
+	StorageClassVolumeBindingModeKeyName = "volumeBindingMode"
+	// StorageClassMountOptionsKeyName is the mount options key name of the StorageClass value map
+	StorageClassMountOptionsKeyName = "mountOptions"
+	// StorageClassEncryptedKeyName is the encrypted key name of the StorageClass value map
+	StorageClassEncryptedKeyName = "encrypted"
+	// StorageClassAllowedZonesKeyName is the allowed zones key name of the StorageClass value map
+	StorageClassAllowedZonesKeyName = "allowedZones"
+	// StorageClassParametersKeyName is the parameters key name of the StorageClass value map
+	StorageClassParametersKeyName = "parameters"
+)
+
+const (
+	// ConditionTypeVolumeClassesAvailable is the ControlPlane condition type which reports whether all VolumeClasses
+	// referenced by StorageClasses are available.
+	ConditionTypeVolumeClassesAvailable gardencorev1beta1.ConditionType = "VolumeClassesAvailable"
 )
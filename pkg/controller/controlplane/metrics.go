@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	"github.com/gardener/gardener/extensions/pkg/controller/controlplane"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+
+	"github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/metrics"
+)
+
+// metricsActuator records the duration and the result of the operations of the wrapped controlplane actuator.
+type metricsActuator struct {
+	controlplane.Actuator
+}
+
+func (a *metricsActuator) Reconcile(ctx context.Context, log logr.Logger, cp *extensionsv1alpha1.ControlPlane, cluster *extensionscontroller.Cluster) (bool, error) {
+	var requeue bool
+	err := metrics.ObserveActuator(controlplane.ControllerName, metrics.OperationReconcile, func() error {
+		var err error
+		requeue, err = a.Actuator.Reconcile(ctx, log, cp, cluster)
+		return err
+	})
+	return requeue, err
+}
+
+func (a *metricsActuator) Delete(ctx context.Context, log logr.Logger, cp *extensionsv1alpha1.ControlPlane, cluster *extensionscontroller.Cluster) error {
+	return metrics.ObserveActuator(controlplane.ControllerName, metrics.OperationDelete, func() error {
+		return a.Actuator.Delete(ctx, log, cp, cluster)
+	})
+}
+
+func (a *metricsActuator) ForceDelete(ctx context.Context, log logr.Logger, cp *extensionsv1alpha1.ControlPlane, cluster *extensionscontroller.Cluster) error {
+	return metrics.ObserveActuator(controlplane.ControllerName, metrics.OperationForceDelete, func() error {
+		return a.Actuator.ForceDelete(ctx, log, cp, cluster)
+	})
+}
+
+func (a *metricsActuator) Restore(ctx context.Context, log logr.Logger, cp *extensionsv1alpha1.ControlPlane, cluster *extensionscontroller.Cluster) (bool, error) {
+	var requeue bool
+	err := metrics.ObserveActuator(controlplane.ControllerName, metrics.OperationRestore, func() error {
+		var err error
+		requeue, err = a.Actuator.Restore(ctx, log, cp, cluster)
+		return err
+	})
+	return requeue, err
+}
+
+func (a *metricsActuator) Migrate(ctx context.Context, log logr.Logger, cp *extensionsv1alpha1.ControlPlane, cluster *extensionscontroller.Cluster) error {
+	return metrics.ObserveActuator(controlplane.ControllerName, metrics.OperationMigrate, func() error {
+		return a.Actuator.Migrate(ctx, log, cp, cluster)
+	})
+}
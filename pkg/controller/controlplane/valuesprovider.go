@@ -5,15 +5,15 @@ package controlplane
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"path/filepath"
+	"slices"
 	"strings"
+	"sync"
 
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	"github.com/gardener/gardener/extensions/pkg/controller/controlplane/genericactuator"
 	extensionssecretsmanager "github.com/gardener/gardener/extensions/pkg/util/secret/manager"
-	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/utils/chart"
@@ -26,10 +26,13 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	storagev1 "k8s.io/api/storage/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/sets"
 	autoscalingv1 "k8s.io/autoscaler/vertical-pod-autoscaler/pkg/apis/autoscaling.k8s.io/v1"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
@@ -37,6 +40,7 @@ import (
 	apisironcore "github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/apis/ironcore"
 	"github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/internal"
 	"github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/ironcore"
+	"github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/ironcore/helper"
 )
 
 const (
@@ -74,6 +78,8 @@ func shootAccessSecretsFunc(namespace string) []*gutil.AccessSecret {
 		gutil.NewShootAccessSecret(ironcore.CSIProvisionerName, namespace),
 		gutil.NewShootAccessSecret(ironcore.CSIAttacherName, namespace),
 		gutil.NewShootAccessSecret(ironcore.CSIResizerName, namespace),
+		gutil.NewShootAccessSecret(ironcore.CSISnapshotterName, namespace),
+		gutil.NewShootAccessSecret(ironcore.CSISnapshotControllerName, namespace),
 		// TODO: This needs to be fixed!!!
 		//		 Since the csi controller needs to access the Node resources in the Shoot cluster,
 		//		 it should use the same ServiceAccount as the csi-driver-node in the Shoot. That way
@@ -114,6 +120,8 @@ var (
 					ironcore.CSIProvisionerImageName,
 					ironcore.CSIAttacherImageName,
 					ironcore.CSIResizerImageName,
+					ironcore.CSISnapshotterImageName,
+					ironcore.CSISnapshotControllerImageName,
 					ironcore.CSILivenessProbeImageName,
 				},
 				Objects: []*chart.Object{
@@ -121,6 +129,9 @@ var (
 					{Type: &appsv1.Deployment{}, Name: ironcore.CSIControllerName},
 					{Type: &corev1.ConfigMap{}, Name: ironcore.CSIControllerObservabilityConfigName},
 					{Type: &autoscalingv1.VerticalPodAutoscaler{}, Name: ironcore.CSIControllerName + "-vpa"},
+					// csi-snapshot-controller
+					{Type: &appsv1.Deployment{}, Name: ironcore.CSISnapshotControllerName},
+					{Type: &autoscalingv1.VerticalPodAutoscaler{}, Name: ironcore.CSISnapshotControllerName + "-vpa"},
 				},
 			},
 		},
@@ -170,11 +181,32 @@ var (
 					{Type: &rbacv1.ClusterRoleBinding{}, Name: ironcore.UsernamePrefix + ironcore.CSIResizerName},
 					{Type: &rbacv1.Role{}, Name: ironcore.UsernamePrefix + ironcore.CSIResizerName},
 					{Type: &rbacv1.RoleBinding{}, Name: ironcore.UsernamePrefix + ironcore.CSIResizerName},
+					// csi-snapshotter
+					{Type: &rbacv1.ClusterRole{}, Name: ironcore.UsernamePrefix + ironcore.CSISnapshotterName},
+					{Type: &rbacv1.ClusterRoleBinding{}, Name: ironcore.UsernamePrefix + ironcore.CSISnapshotterName},
+					{Type: &rbacv1.Role{}, Name: ironcore.UsernamePrefix + ironcore.CSISnapshotterName},
+					{Type: &rbacv1.RoleBinding{}, Name: ironcore.UsernamePrefix + ironcore.CSISnapshotterName},
+					// csi-snapshot-controller
+					{Type: &rbacv1.ClusterRole{}, Name: ironcore.UsernamePrefix + ironcore.CSISnapshotControllerName},
+					{Type: &rbacv1.ClusterRoleBinding{}, Name: ironcore.UsernamePrefix + ironcore.CSISnapshotControllerName},
+					{Type: &rbacv1.Role{}, Name: ironcore.UsernamePrefix + ironcore.CSISnapshotControllerName},
+					{Type: &rbacv1.RoleBinding{}, Name: ironcore.UsernamePrefix + ironcore.CSISnapshotControllerName},
 				},
 			},
 		},
 	}
 
+	controlPlaneShootCRDsChart = &chart.Chart{
+		Name:       "shoot-crds",
+		EmbeddedFS: charts.InternalChart,
+		Path:       filepath.Join(charts.InternalChartsPath, "shoot-crds"),
+		Objects: []*chart.Object{
+			{Type: &apiextensionsv1.CustomResourceDefinition{}, Name: "volumesnapshotclasses.snapshot.storage.k8s.io"},
+			{Type: &apiextensionsv1.CustomResourceDefinition{}, Name: "volumesnapshotcontents.snapshot.storage.k8s.io"},
+			{Type: &apiextensionsv1.CustomResourceDefinition{}, Name: "volumesnapshots.snapshot.storage.k8s.io"},
+		},
+	}
+
 	storageClassChart = &chart.Chart{
 		Name:       "shoot-storageclasses",
 		EmbeddedFS: charts.InternalChart,
@@ -186,10 +218,18 @@ var (
 type valuesProvider struct {
 	client  client.Client
 	decoder runtime.Decoder
+
+	// missingVolumeClasses holds the VolumeClasses which could not be found when the StorageClass chart values of a
+	// ControlPlane were computed, keyed by the client.ObjectKey of the ControlPlane.
+	missingVolumeClasses sync.Map
 }
 
 // NewValuesProvider creates a new ValuesProvider for the generic actuator.
 func NewValuesProvider(mgr manager.Manager) genericactuator.ValuesProvider {
+	return newValuesProvider(mgr)
+}
+
+func newValuesProvider(mgr manager.Manager) *valuesProvider {
 	return &valuesProvider{
 		client:  mgr.GetClient(),
 		decoder: serializer.NewCodecFactory(mgr.GetScheme(), serializer.EnableStrict).UniversalDecoder(),
@@ -214,12 +254,81 @@ func (vp *valuesProvider) GetConfigChartValues(
 	if _, _, err := vp.decoder.Decode(cp.Spec.InfrastructureProviderStatus.Raw, nil, infrastructureStatus); err != nil {
 		return nil, fmt.Errorf("failed to decode infrastructure status: %w", err)
 	}
+	cpConfig, err := vp.decodeControlPlaneConfig(cp)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := extensionscontroller.GetSecretByReference(ctx, vp.client, &cp.Spec.SecretRef)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cloudprovider secret for controlplane '%s': %w", client.ObjectKeyFromObject(cp), err)
+	}
+	namespace, ok := secret.Data[ironcore.NamespaceFieldName]
+	if !ok {
+		return nil, fmt.Errorf("could not find a namespace in the cloudprovider secret of controlplane '%s'", client.ObjectKeyFromObject(cp))
+	}
+
+	overlayEnabled, err := helper.IsOverlayEnabled(cluster.Shoot.Spec.Networking)
+	if err != nil {
+		return nil, fmt.Errorf("failed to determine if overlay is enabled: %w", err)
+	}
+
 	// Collect config chart values
-	return map[string]interface{}{
-		ironcore.NetworkFieldName: infrastructureStatus.NetworkRef.Name,
-		ironcore.PrefixFieldName:  infrastructureStatus.PrefixRef.Name,
-		ironcore.ClusterFieldName: cluster.ObjectMeta.Name,
-	}, nil
+	values := map[string]interface{}{
+		ironcore.NetworkFieldName:       infrastructureStatus.NetworkRef.Name,
+		ironcore.PrefixFieldName:        infrastructureStatus.PrefixRef.Name,
+		ironcore.NATGatewayFieldName:    infrastructureStatus.NATGatewayRef.Name,
+		ironcore.NetworkPolicyFieldName: infrastructureStatus.NetworkPolicyRef.Name,
+		ironcore.ClusterFieldName:       cluster.ObjectMeta.Name,
+		ironcore.NamespaceFieldName:     string(namespace),
+		ironcore.RegionFieldName:        cp.Spec.Region,
+		ironcore.ZonesFieldName:         getWorkerZones(cluster),
+		"loadBalancer":                  getLoadBalancerConfigValues(cpConfig),
+	}
+	if !overlayEnabled {
+		values["routeController"] = map[string]interface{}{
+			"podPrefixMode": string(getPodPrefixMode(cpConfig)),
+		}
+	}
+
+	return values, nil
+}
+
+// getPodPrefixMode returns the mode in which the route controller attaches the pod CIDRs of nodes to their
+// NetworkInterfaces.
+func getPodPrefixMode(cpConfig *apisironcore.ControlPlaneConfig) apisironcore.PodPrefixMode {
+	if ccm := cpConfig.CloudControllerManager; ccm != nil && ccm.RouteController != nil && ccm.RouteController.PodPrefixMode != nil {
+		return *ccm.RouteController.PodPrefixMode
+	}
+	return apisironcore.PodPrefixModeRouted
+}
+
+// getLoadBalancerConfigValues returns the load balancer settings of the cloud-provider-config.
+func getLoadBalancerConfigValues(cpConfig *apisironcore.ControlPlaneConfig) map[string]interface{} {
+	values := map[string]interface{}{
+		"defaultType":            string(apisironcore.LoadBalancerTypePublic),
+		"sourceRangeEnforcement": string(apisironcore.SourceRangeEnforcementModeEnforce),
+	}
+	if cpConfig.CloudControllerManager == nil || cpConfig.CloudControllerManager.LoadBalancer == nil {
+		return values
+	}
+
+	lb := cpConfig.CloudControllerManager.LoadBalancer
+	if lb.DefaultType != nil {
+		values["defaultType"] = string(*lb.DefaultType)
+	}
+	if lb.SourceRangeEnforcement != nil {
+		values["sourceRangeEnforcement"] = string(*lb.SourceRangeEnforcement)
+	}
+	if lb.VirtualIP != nil {
+		if lb.VirtualIP.Prefix != nil {
+			values["virtualIPPrefix"] = *lb.VirtualIP.Prefix
+		}
+		if lb.VirtualIP.PoolRef != nil {
+			values["virtualIPPoolName"] = lb.VirtualIP.PoolRef.Name
+		}
+	}
+	return values
 }
 
 // GetControlPlaneChartValues returns the values for the control plane chart applied by the generic actuator.
@@ -234,20 +343,23 @@ func (vp *valuesProvider) GetControlPlaneChartValues(
 	map[string]interface{},
 	error,
 ) {
-	cpConfig := &apisironcore.ControlPlaneConfig{}
-	if cp.Spec.ProviderConfig != nil {
-		if _, _, err := vp.decoder.Decode(cp.Spec.ProviderConfig.Raw, nil, cpConfig); err != nil {
-			return nil, fmt.Errorf("could not decode providerConfig of controlplane '%s': %w", client.ObjectKeyFromObject(cp), err)
-		}
+	cpConfig, err := vp.decodeControlPlaneConfig(cp)
+	if err != nil {
+		return nil, err
+	}
+
+	cloudProfileConfig, err := vp.decodeCloudProfileConfig(cp, cluster)
+	if err != nil {
+		return nil, err
 	}
 
-	return getControlPlaneChartValues(cpConfig, cp, cluster, secretsReader, checksums, scaledDown)
+	return getControlPlaneChartValues(cpConfig, cloudProfileConfig, cp, cluster, secretsReader, checksums, scaledDown)
 }
 
 // GetControlPlaneShootChartValues returns the values for the control plane shoot chart applied by the generic actuator.
 func (vp *valuesProvider) GetControlPlaneShootChartValues(
 	_ context.Context,
-	_ *extensionsv1alpha1.ControlPlane,
+	cp *extensionsv1alpha1.ControlPlane,
 	cluster *extensionscontroller.Cluster,
 	_ secretsmanager.Reader,
 	_ map[string]string,
@@ -255,11 +367,16 @@ func (vp *valuesProvider) GetControlPlaneShootChartValues(
 	map[string]interface{},
 	error,
 ) {
-	return vp.getControlPlaneShootChartValues(cluster)
+	cpConfig, err := vp.decodeControlPlaneConfig(cp)
+	if err != nil {
+		return nil, err
+	}
+
+	return vp.getControlPlaneShootChartValues(cpConfig, cluster)
 }
 
 // GetControlPlaneShootCRDsChartValues returns the values for the control plane shoot CRDs chart applied by the generic actuator.
-// Currently, the provider extension does not specify a control plane shoot CRDs chart. That's why we simply return empty values.
+// The chart only contains the volume snapshot CRDs which are always deployed, hence no values are needed.
 func (vp *valuesProvider) GetControlPlaneShootCRDsChartValues(
 	_ context.Context,
 	_ *extensionsv1alpha1.ControlPlane,
@@ -274,69 +391,273 @@ func (vp *valuesProvider) GetStorageClassesChartValues(
 	controlPlane *extensionsv1alpha1.ControlPlane,
 	cluster *extensionscontroller.Cluster,
 ) (map[string]interface{}, error) {
-	providerConfig := apisironcore.CloudProfileConfig{}
-	if config := cluster.CloudProfile.Spec.ProviderConfig; config != nil {
-		if _, _, err := vp.decoder.Decode(config.Raw, nil, &providerConfig); err != nil {
-			return nil, fmt.Errorf("could not decode cloudprofile providerConfig for controlplane '%s': %w", client.ObjectKeyFromObject(controlPlane), err)
+	cpConfig, err := vp.decodeControlPlaneConfig(controlPlane)
+	if err != nil {
+		return nil, err
+	}
+
+	storageClasses, missingVolumeClasses, err := vp.getStorageClassesValues(ctx, controlPlane, cluster)
+	if err != nil {
+		return nil, err
+	}
+	if controlPlane != nil {
+		vp.missingVolumeClasses.Store(client.ObjectKeyFromObject(controlPlane), missingVolumeClasses)
+	}
+
+	values := map[string]interface{}{
+		"storageClasses": storageClasses,
+	}
+
+	if volumeSnapshotsEnabled(cpConfig) {
+		providerConfig, err := vp.decodeCloudProfileConfig(controlPlane, cluster)
+		if err != nil {
+			return nil, err
 		}
+		values["volumeSnapshotClasses"] = getVolumeSnapshotClassesValues(providerConfig.VolumeSnapshotClasses)
+	}
+
+	return values, nil
+}
+
+// getStorageClassesValues returns the chart values of the StorageClasses of the shoot, together with the VolumeClasses
+// referenced by StorageClasses which could not be found. StorageClasses of missing VolumeClasses are skipped.
+func (vp *valuesProvider) getStorageClassesValues(
+	ctx context.Context,
+	controlPlane *extensionsv1alpha1.ControlPlane,
+	cluster *extensionscontroller.Cluster,
+) ([]map[string]interface{}, []string, error) {
+	providerConfig, err := vp.decodeCloudProfileConfig(controlPlane, cluster)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	values := make(map[string]interface{})
-	var defaultStorageClass int
-	if providerConfig.StorageClasses.Default != nil {
-		defaultStorageClass++
+	cpConfig, err := vp.decodeControlPlaneConfig(controlPlane)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	// get ironcore credentials from infrastructure config
 	ironcoreClient, _, err := ironcore.GetIroncoreClientAndNamespaceFromCloudProviderSecret(ctx, vp.client, cluster.ObjectMeta.Name)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get ironcore client and namespace from cloudprovider secret: %w", err)
+		return nil, nil, fmt.Errorf("failed to get ironcore client and namespace from cloudprovider secret: %w", err)
+	}
+
+	var volumeClasses map[string]storagev1alpha1.VolumeClass
+	defaultStorageClass, additionalStorageClasses := mergeStorageClasses(providerConfig.StorageClasses, cpConfig.Storage)
+	if providerConfig.StorageClasses.DiscoverVolumeClasses {
+		volumeClassList := &storagev1alpha1.VolumeClassList{}
+		if err := ironcoreClient.List(ctx, volumeClassList); err != nil {
+			return nil, nil, fmt.Errorf("could not list volumeclasses: %w", err)
+		}
+		volumeClasses = make(map[string]storagev1alpha1.VolumeClass, len(volumeClassList.Items))
+		for _, volumeClass := range volumeClassList.Items {
+			volumeClasses[volumeClass.Name] = volumeClass
+		}
+		additionalStorageClasses = append(additionalStorageClasses, discoverStorageClasses(defaultStorageClass, additionalStorageClasses, volumeClassList.Items)...)
+	} else {
+		referencedStorageClasses := additionalStorageClasses
+		if defaultStorageClass != nil {
+			referencedStorageClasses = append([]apisironcore.StorageClass{*defaultStorageClass}, referencedStorageClasses...)
+		}
+		volumeClasses, err = getVolumeClasses(ctx, ironcoreClient, referencedStorageClasses)
+		if err != nil {
+			return nil, nil, err
+		}
 	}
 
-	var expandable bool
-	storageClasses := make([]map[string]interface{}, 0, len(providerConfig.StorageClasses.Additional)+defaultStorageClass)
-	if providerConfig.StorageClasses.Default != nil {
-		if expandable, err = isVolumeClassExpandable(ctx, ironcoreClient, providerConfig.StorageClasses.Default); err != nil {
-			return nil, fmt.Errorf("could not get resize policy from volumeclass : %w", err)
+	var missingVolumeClasses []string
+	storageClasses := make([]map[string]interface{}, 0, len(additionalStorageClasses)+1)
+	if defaultStorageClass != nil {
+		if volumeClass, ok := volumeClasses[defaultStorageClass.Type]; ok {
+			scValues := getStorageClassValues(defaultStorageClass, &volumeClass)
+			scValues[StorageClassDefaultKeyName] = true
+			storageClasses = append(storageClasses, scValues)
+		} else {
+			missingVolumeClasses = append(missingVolumeClasses, defaultStorageClass.Type)
 		}
+	}
+	for _, sc := range additionalStorageClasses {
+		if volumeClass, ok := volumeClasses[sc.Type]; ok {
+			storageClasses = append(storageClasses, getStorageClassValues(&sc, &volumeClass))
+		} else {
+			missingVolumeClasses = append(missingVolumeClasses, sc.Type)
+		}
+	}
 
-		storageClasses = append(storageClasses, map[string]interface{}{
-			StorageClassNameKeyName:       providerConfig.StorageClasses.Default.Name,
-			StorageClassTypeKeyName:       providerConfig.StorageClasses.Default.Type,
-			StorageClassDefaultKeyName:    true,
-			StorageClassExpandableKeyName: expandable,
-		})
+	return storageClasses, missingVolumeClasses, nil
+}
+
+// popMissingVolumeClasses returns and forgets the VolumeClasses which could not be found when the StorageClass chart
+// values of the given ControlPlane were computed. It returns false if they have not been computed.
+func (vp *valuesProvider) popMissingVolumeClasses(cp *extensionsv1alpha1.ControlPlane) ([]string, bool) {
+	missingVolumeClasses, ok := vp.missingVolumeClasses.LoadAndDelete(client.ObjectKeyFromObject(cp))
+	if !ok {
+		return nil, false
 	}
-	for _, sc := range providerConfig.StorageClasses.Additional {
-		if expandable, err = isVolumeClassExpandable(ctx, ironcoreClient, &sc); err != nil {
-			return nil, fmt.Errorf("could not get resize policy from volumeclass : %w", err)
+	return missingVolumeClasses.([]string), true
+}
+
+// getVolumeClasses gets the VolumeClasses referenced by the given StorageClasses by their name. VolumeClasses which do
+// not exist are left out.
+func getVolumeClasses(ctx context.Context, ironcoreClient client.Client, storageClasses []apisironcore.StorageClass) (map[string]storagev1alpha1.VolumeClass, error) {
+	volumeClasses := make(map[string]storagev1alpha1.VolumeClass, len(storageClasses))
+	for _, sc := range storageClasses {
+		if _, ok := volumeClasses[sc.Type]; ok {
+			continue
+		}
+		volumeClass := &storagev1alpha1.VolumeClass{}
+		if err := ironcoreClient.Get(ctx, client.ObjectKey{Name: sc.Type}, volumeClass); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, fmt.Errorf("could not get volumeclass %s: %w", sc.Type, err)
+		}
+		volumeClasses[sc.Type] = *volumeClass
+	}
+	return volumeClasses, nil
+}
+
+// mergeStorageClasses applies the StorageClasses of the shoot to the ones of the CloudProfile. A shoot StorageClass
+// replaces the CloudProfile StorageClass with the same name, all others are added as additional StorageClasses.
+func mergeStorageClasses(profileClasses apisironcore.StorageClasses, storage *apisironcore.Storage) (*apisironcore.StorageClass, []apisironcore.StorageClass) {
+	defaultStorageClass := profileClasses.Default
+	additionalStorageClasses := slices.Clone(profileClasses.Additional)
+	if storage == nil {
+		return defaultStorageClass, additionalStorageClasses
+	}
+
+	for _, sc := range storage.StorageClasses {
+		if defaultStorageClass != nil && defaultStorageClass.Name == sc.Name {
+			defaultStorageClass = &sc
+			continue
+		}
+		if idx := slices.IndexFunc(additionalStorageClasses, func(additional apisironcore.StorageClass) bool {
+			return additional.Name == sc.Name
+		}); idx >= 0 {
+			additionalStorageClasses[idx] = sc
+			continue
 		}
-		storageClasses = append(storageClasses, map[string]interface{}{
-			StorageClassNameKeyName:       sc.Name,
-			StorageClassTypeKeyName:       sc.Type,
-			StorageClassExpandableKeyName: expandable,
+		additionalStorageClasses = append(additionalStorageClasses, sc)
+	}
+	return defaultStorageClass, additionalStorageClasses
+}
+
+// discoverStorageClasses returns a StorageClass for every VolumeClass which is not referenced by one of the given
+// StorageClasses. VolumeClasses whose name is already used by a StorageClass are skipped.
+func discoverStorageClasses(defaultStorageClass *apisironcore.StorageClass, additionalStorageClasses []apisironcore.StorageClass, volumeClasses []storagev1alpha1.VolumeClass) []apisironcore.StorageClass {
+	usedNames, usedTypes := sets.New[string](), sets.New[string]()
+	if defaultStorageClass != nil {
+		usedNames.Insert(defaultStorageClass.Name)
+		usedTypes.Insert(defaultStorageClass.Type)
+	}
+	for _, sc := range additionalStorageClasses {
+		usedNames.Insert(sc.Name)
+		usedTypes.Insert(sc.Type)
+	}
+
+	var discovered []apisironcore.StorageClass
+	for _, volumeClass := range volumeClasses {
+		if usedTypes.Has(volumeClass.Name) || usedNames.Has(volumeClass.Name) {
+			continue
+		}
+		discovered = append(discovered, apisironcore.StorageClass{
+			Name: volumeClass.Name,
+			Type: volumeClass.Name,
 		})
 	}
+	slices.SortFunc(discovered, func(a, b apisironcore.StorageClass) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return discovered
+}
 
-	values["storageClasses"] = storageClasses
+// getStorageClassValues returns the chart values of the given StorageClass.
+func getStorageClassValues(sc *apisironcore.StorageClass, volumeClass *storagev1alpha1.VolumeClass) map[string]interface{} {
+	values := map[string]interface{}{
+		StorageClassNameKeyName:       sc.Name,
+		StorageClassTypeKeyName:       sc.Type,
+		StorageClassExpandableKeyName: volumeClass.ResizePolicy == storagev1alpha1.ResizePolicyExpandOnly,
+	}
+	if sc.ReclaimPolicy != nil {
+		values[StorageClassReclaimPolicyKeyName] = string(*sc.ReclaimPolicy)
+	}
+	if sc.VolumeBindingMode != nil {
+		values[StorageClassVolumeBindingModeKeyName] = string(*sc.VolumeBindingMode)
+	}
+	if len(sc.MountOptions) > 0 {
+		values[StorageClassMountOptionsKeyName] = sc.MountOptions
+	}
+	if ptr.Deref(sc.Encrypted, false) {
+		values[StorageClassEncryptedKeyName] = true
+	}
+	if len(sc.AllowedZones) > 0 {
+		values[StorageClassAllowedZonesKeyName] = sc.AllowedZones
+	}
+	if len(sc.Parameters) > 0 {
+		values[StorageClassParametersKeyName] = sc.Parameters
+	}
+	return values
+}
 
-	return values, nil
+func getVolumeSnapshotClassesValues(classes []apisironcore.VolumeSnapshotClass) []map[string]interface{} {
+	volumeSnapshotClasses := make([]map[string]interface{}, 0, len(classes))
+	for _, class := range classes {
+		values := map[string]interface{}{
+			"name":           class.Name,
+			"default":        ptr.Deref(class.Default, false),
+			"deletionPolicy": string(ptr.Deref(class.DeletionPolicy, apisironcore.VolumeSnapshotDeletionPolicyDelete)),
+		}
+		if len(class.Parameters) > 0 {
+			values["parameters"] = class.Parameters
+		}
+		volumeSnapshotClasses = append(volumeSnapshotClasses, values)
+	}
+	return volumeSnapshotClasses
+}
+
+// getWorkerZones returns the sorted zones of all worker pools of the shoot.
+func getWorkerZones(cluster *extensionscontroller.Cluster) []string {
+	if cluster.Shoot == nil {
+		return nil
+	}
+	zones := sets.New[string]()
+	for _, worker := range cluster.Shoot.Spec.Provider.Workers {
+		zones.Insert(worker.Zones...)
+	}
+	return sets.List(zones)
+}
+
+// decodeCloudProfileConfig decodes the CloudProfileConfig of the CloudProfile of the given cluster.
+func (vp *valuesProvider) decodeCloudProfileConfig(cp *extensionsv1alpha1.ControlPlane, cluster *extensionscontroller.Cluster) (*apisironcore.CloudProfileConfig, error) {
+	cloudProfileConfig := &apisironcore.CloudProfileConfig{}
+	if cluster.CloudProfile != nil && cluster.CloudProfile.Spec.ProviderConfig != nil {
+		if _, _, err := vp.decoder.Decode(cluster.CloudProfile.Spec.ProviderConfig.Raw, nil, cloudProfileConfig); err != nil {
+			return nil, fmt.Errorf("could not decode cloudprofile providerConfig for controlplane '%s': %w", client.ObjectKeyFromObject(cp), err)
+		}
+	}
+	return cloudProfileConfig, nil
 }
 
-func isVolumeClassExpandable(ctx context.Context, ironcoreClient client.Client, storageClass *apisironcore.StorageClass) (bool, error) {
-	volumeClass := &storagev1alpha1.VolumeClass{}
-	if err := ironcoreClient.Get(ctx, client.ObjectKey{Name: storageClass.Type}, volumeClass); err != nil {
-		if apierrors.IsNotFound(err) {
-			return false, fmt.Errorf("VolumeClass not found")
+// decodeControlPlaneConfig decodes the ControlPlaneConfig of the given ControlPlane. An empty config is returned
+// if the ControlPlane or its providerConfig is not set.
+func (vp *valuesProvider) decodeControlPlaneConfig(cp *extensionsv1alpha1.ControlPlane) (*apisironcore.ControlPlaneConfig, error) {
+	cpConfig := &apisironcore.ControlPlaneConfig{}
+	if cp != nil && cp.Spec.ProviderConfig != nil {
+		if _, _, err := vp.decoder.Decode(cp.Spec.ProviderConfig.Raw, nil, cpConfig); err != nil {
+			return nil, fmt.Errorf("could not decode providerConfig of controlplane '%s': %w", client.ObjectKeyFromObject(cp), err)
 		}
-		return false, fmt.Errorf("could not get volumeclass: %w", err)
 	}
-	return volumeClass.ResizePolicy == storagev1alpha1.ResizePolicyExpandOnly, nil
+	return cpConfig, nil
+}
+
+func volumeSnapshotsEnabled(cpConfig *apisironcore.ControlPlaneConfig) bool {
+	return cpConfig.Storage != nil && cpConfig.Storage.VolumeSnapshots != nil && cpConfig.Storage.VolumeSnapshots.Enabled
 }
 
 // getControlPlaneChartValues collects and returns the control plane chart values.
 func getControlPlaneChartValues(
 	cpConfig *apisironcore.ControlPlaneConfig,
+	cloudProfileConfig *apisironcore.CloudProfileConfig,
 	cp *extensionsv1alpha1.ControlPlane,
 	cluster *extensionscontroller.Cluster,
 	secretsReader secretsmanager.Reader,
@@ -351,7 +672,7 @@ func getControlPlaneChartValues(
 		return nil, err
 	}
 
-	csi, err := getCSIControllerChartValues(cpConfig, cp, cluster, secretsReader, checksums, scaledDown)
+	csi, err := getCSIControllerChartValues(cpConfig, cloudProfileConfig, cp, cluster, secretsReader, checksums, scaledDown)
 	if err != nil {
 		return nil, err
 	}
@@ -398,9 +719,12 @@ func getCCMChartValues(
 
 	if cpConfig.CloudControllerManager != nil {
 		values["featureGates"] = cpConfig.CloudControllerManager.FeatureGates
+		if rc := cpConfig.CloudControllerManager.RouteController; rc != nil && rc.ReconciliationPeriod != nil {
+			values["routeReconciliationPeriod"] = rc.ReconciliationPeriod.Duration.String()
+		}
 	}
 
-	overlayEnabled, err := isOverlayEnabled(cluster.Shoot.Spec.Networking)
+	overlayEnabled, err := helper.IsOverlayEnabled(cluster.Shoot.Spec.Networking)
 	if err != nil {
 		return nil, fmt.Errorf("failed to determine if overlay is enabled: %w", err)
 	}
@@ -409,50 +733,56 @@ func getCCMChartValues(
 	return values, nil
 }
 
-func isOverlayEnabled(networking *gardencorev1beta1.Networking) (bool, error) {
-	if networking == nil || networking.ProviderConfig == nil {
-		return true, nil
-	}
-
-	detectOverlay := &struct {
-		Overlay *struct {
-			Enabled bool `json:"enabled"`
-		} `json:"overlay,omitempty"`
-	}{}
-	if err := json.Unmarshal(networking.ProviderConfig.Raw, detectOverlay); err != nil {
-		return false, fmt.Errorf("failed to unmarshal network provider config: %w", err)
-	}
-
-	overlay := detectOverlay.Overlay
-	if overlay == nil {
-		return false, nil
-	}
-
-	return overlay.Enabled, nil
-}
-
 // getCSIControllerChartValues collects and returns the CSIController chart values.
 func getCSIControllerChartValues(
-	_ *apisironcore.ControlPlaneConfig,
-	_ *extensionsv1alpha1.ControlPlane,
+	cpConfig *apisironcore.ControlPlaneConfig,
+	cloudProfileConfig *apisironcore.CloudProfileConfig,
+	cp *extensionsv1alpha1.ControlPlane,
 	cluster *extensionscontroller.Cluster,
 	_ secretsmanager.Reader,
 	_ map[string]string,
 	scaledDown bool,
 ) (map[string]interface{}, error) {
-	return map[string]interface{}{
+	values := map[string]interface{}{
 		"enabled":  true,
 		"replicas": extensionscontroller.GetControlPlaneReplicas(cluster, scaledDown, 1),
-	}, nil
+		"region":   cp.Spec.Region,
+		"volumeSnapshots": map[string]interface{}{
+			"enabled": volumeSnapshotsEnabled(cpConfig),
+		},
+	}
+	if volumePools := getZoneVolumePools(cloudProfileConfig, cp.Spec.Region); len(volumePools) > 0 {
+		values["volumePools"] = volumePools
+	}
+	return values, nil
+}
+
+// getZoneVolumePools returns the configured VolumePool per zone of the given region.
+func getZoneVolumePools(cloudProfileConfig *apisironcore.CloudProfileConfig, region string) map[string]interface{} {
+	volumePools := make(map[string]interface{})
+	for _, regionConfig := range cloudProfileConfig.RegionConfigs {
+		if regionConfig.Name != region {
+			continue
+		}
+		for _, zone := range regionConfig.Zones {
+			if zone.VolumePoolName != nil {
+				volumePools[zone.Name] = *zone.VolumePoolName
+			}
+		}
+	}
+	return volumePools
 }
 
 // getControlPlaneShootChartValues collects and returns the control plane shoot chart values.
-func (vp *valuesProvider) getControlPlaneShootChartValues(cluster *extensionscontroller.Cluster) (map[string]interface{}, error) {
+func (vp *valuesProvider) getControlPlaneShootChartValues(cpConfig *apisironcore.ControlPlaneConfig, cluster *extensionscontroller.Cluster) (map[string]interface{}, error) {
 	if cluster.Shoot == nil {
 		return nil, fmt.Errorf("cluster %s does not contain a shoot object", cluster.ObjectMeta.Name)
 	}
 	csiNodeDriverValues := map[string]interface{}{
 		"enabled": true,
+		"volumeSnapshots": map[string]interface{}{
+			"enabled": volumeSnapshotsEnabled(cpConfig),
+		},
 	}
 
 	return map[string]interface{}{
@@ -22,6 +22,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -78,6 +79,12 @@ var _ = Describe("Valueprovider Reconcile", func() {
 									FeatureGates: map[string]bool{
 										"CustomResourceValidation": true,
 									},
+									LoadBalancer: &apisironcore.LoadBalancerConfig{
+										DefaultType: ptr.To(apisironcore.LoadBalancerTypeInternal),
+										VirtualIP: &apisironcore.VirtualIPConfig{
+											PoolRef: &corev1.LocalObjectReference{Name: "my-vip-pool"},
+										},
+									},
 								},
 							}),
 						},
@@ -92,6 +99,14 @@ var _ = Describe("Valueprovider Reconcile", func() {
 								Name: "my-prefix",
 								UID:  "6789",
 							},
+							NATGatewayRef: v1alpha1.LocalUIDReference{
+								Name: "my-nat",
+								UID:  "2345",
+							},
+							NetworkPolicyRef: v1alpha1.LocalUIDReference{
+								Name: "my-network-policy",
+								UID:  "3456",
+							},
 						}),
 					},
 				},
@@ -149,6 +164,16 @@ var _ = Describe("Valueprovider Reconcile", func() {
 			Expect(cloudProviderConfig["networkName"]).To(Equal("my-network"))
 			Expect(cloudProviderConfig["prefixName"]).To(Equal("my-prefix"))
 			Expect(cloudProviderConfig["clusterName"]).To(Equal(cluster.Name))
+			Expect(cloudProviderConfig["natGatewayName"]).To(Equal("my-nat"))
+			Expect(cloudProviderConfig["networkPolicyName"]).To(Equal("my-network-policy"))
+			Expect(cloudProviderConfig["namespace"]).To(Equal(ns.Name))
+			Expect(cloudProviderConfig["region"]).To(Equal("foo"))
+			Expect(cloudProviderConfig["loadBalancer"]).To(Equal(map[string]interface{}{
+				"defaultType":            "Internal",
+				"sourceRangeEnforcement": "Enforce",
+				"virtualIPPoolName":      "my-vip-pool",
+			}))
+			Expect(cloudProviderConfig).NotTo(HaveKey("routeController"))
 		})
 	})
 
@@ -315,13 +340,216 @@ var _ = Describe("Valueprovider Reconcile", func() {
 			}))
 		})
 
-		It("should return error if volumeClass is not available", func(ctx SpecContext) {
+		It("should skip storage classes with missing volumeClasses", func(ctx SpecContext) {
 			providerCloudProfile := &apisironcore.CloudProfileConfig{
 				StorageClasses: apisironcore.StorageClasses{
 					Default: &apisironcore.StorageClass{
 						Name: "foo",
 						Type: "volume-non-existing",
 					},
+					Additional: []apisironcore.StorageClass{
+						{
+							Name: "bar",
+							Type: "volume-static",
+						},
+					},
+				},
+			}
+			providerCloudProfileJson, err := json.Marshal(providerCloudProfile)
+			Expect(err).NotTo(HaveOccurred())
+
+			cp := &extensionsv1alpha1.ControlPlane{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:    ns.Name,
+					GenerateName: "control-plane-",
+				},
+				Spec: extensionsv1alpha1.ControlPlaneSpec{
+					DefaultSpec: extensionsv1alpha1.DefaultSpec{
+						Type: ironcore.Type,
+					},
+					SecretRef: corev1.SecretReference{
+						Name:      "my-infra-creds",
+						Namespace: ns.Name,
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, cp)).To(Succeed())
+			DeferCleanup(k8sClient.Delete, cp)
+
+			cluster := &controller.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: ns.Name,
+				},
+				CloudProfile: &gardencorev1beta1.CloudProfile{
+					Spec: gardencorev1beta1.CloudProfileSpec{
+						ProviderConfig: &runtime.RawExtension{
+							Raw: providerCloudProfileJson,
+						},
+					},
+				},
+			}
+
+			values, err := vp.GetStorageClassesChartValues(ctx, cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(map[string]interface{}{
+				"storageClasses": []map[string]interface{}{
+					{
+						"name":       "bar",
+						"type":       "volume-static",
+						"expandable": false,
+					},
+				},
+			}))
+		})
+
+		It("should generate storage classes for unreferenced volumeClasses if discovery is enabled", func(ctx SpecContext) {
+			providerCloudProfile := &apisironcore.CloudProfileConfig{
+				StorageClasses: apisironcore.StorageClasses{
+					Default: &apisironcore.StorageClass{
+						Name: "foo",
+						Type: "volume-static",
+					},
+					DiscoverVolumeClasses: true,
+				},
+			}
+			providerCloudProfileJson, err := json.Marshal(providerCloudProfile)
+			Expect(err).NotTo(HaveOccurred())
+
+			cluster := &controller.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: ns.Name,
+				},
+				CloudProfile: &gardencorev1beta1.CloudProfile{
+					Spec: gardencorev1beta1.CloudProfileSpec{
+						ProviderConfig: &runtime.RawExtension{
+							Raw: providerCloudProfileJson,
+						},
+					},
+				},
+			}
+
+			values, err := vp.GetStorageClassesChartValues(ctx, nil, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(map[string]interface{}{
+				"storageClasses": []map[string]interface{}{
+					{
+						"name":       "foo",
+						"type":       "volume-static",
+						"default":    true,
+						"expandable": false,
+					},
+					{
+						"name":       "volume-expandable",
+						"type":       "volume-expandable",
+						"expandable": true,
+					},
+				},
+			}))
+		})
+
+		It("should apply the storage classes of the controlplane config to the ones of the cloudprofile", func(ctx SpecContext) {
+			providerCloudProfile := &apisironcore.CloudProfileConfig{
+				StorageClasses: apisironcore.StorageClasses{
+					Default: &apisironcore.StorageClass{
+						Name: "foo",
+						Type: "volume-static",
+					},
+					Additional: []apisironcore.StorageClass{
+						{
+							Name: "bar",
+							Type: "volume-static",
+						},
+					},
+				},
+			}
+			providerCloudProfileJson, err := json.Marshal(providerCloudProfile)
+			Expect(err).NotTo(HaveOccurred())
+
+			cp := &extensionsv1alpha1.ControlPlane{
+				Spec: extensionsv1alpha1.ControlPlaneSpec{
+					DefaultSpec: extensionsv1alpha1.DefaultSpec{
+						ProviderConfig: &runtime.RawExtension{
+							Raw: encode(&apisironcore.ControlPlaneConfig{
+								Storage: &apisironcore.Storage{
+									StorageClasses: []apisironcore.StorageClass{
+										{
+											Name:          "foo",
+											Type:          "volume-expandable",
+											ReclaimPolicy: ptr.To(corev1.PersistentVolumeReclaimRetain),
+											Encrypted:     ptr.To(true),
+										},
+										{
+											Name:              "baz",
+											Type:              "volume-expandable",
+											VolumeBindingMode: ptr.To(storagev1.VolumeBindingImmediate),
+											MountOptions:      []string{"noatime"},
+											AllowedZones:      []string{"zone-a"},
+											Parameters:        map[string]string{"fsType": "xfs"},
+										},
+									},
+								},
+							}),
+						},
+					},
+				},
+			}
+			cluster := &controller.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: ns.Name,
+				},
+				CloudProfile: &gardencorev1beta1.CloudProfile{
+					Spec: gardencorev1beta1.CloudProfileSpec{
+						ProviderConfig: &runtime.RawExtension{
+							Raw: providerCloudProfileJson,
+						},
+					},
+				},
+			}
+
+			values, err := vp.GetStorageClassesChartValues(ctx, cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(map[string]interface{}{
+				"storageClasses": []map[string]interface{}{
+					{
+						"name":          "foo",
+						"type":          "volume-expandable",
+						"default":       true,
+						"expandable":    true,
+						"reclaimPolicy": "Retain",
+						"encrypted":     true,
+					},
+					{
+						"name":       "bar",
+						"type":       "volume-static",
+						"expandable": false,
+					},
+					{
+						"name":              "baz",
+						"type":              "volume-expandable",
+						"expandable":        true,
+						"volumeBindingMode": "Immediate",
+						"mountOptions":      []string{"noatime"},
+						"allowedZones":      []string{"zone-a"},
+						"parameters":        map[string]string{"fsType": "xfs"},
+					},
+				},
+			}))
+		})
+
+		It("should only restrict storage classes with allowed zones", func(ctx SpecContext) {
+			providerCloudProfile := &apisironcore.CloudProfileConfig{
+				StorageClasses: apisironcore.StorageClasses{
+					Default: &apisironcore.StorageClass{
+						Name: "foo",
+						Type: "volume-static",
+					},
+					Additional: []apisironcore.StorageClass{
+						{
+							Name:         "bar",
+							Type:         "volume-static",
+							AllowedZones: []string{"zone-c"},
+						},
+					},
 				},
 			}
 			providerCloudProfileJson, err := json.Marshal(providerCloudProfile)
@@ -338,10 +566,99 @@ var _ = Describe("Valueprovider Reconcile", func() {
 						},
 					},
 				},
+				Shoot: &gardencorev1beta1.Shoot{
+					Spec: gardencorev1beta1.ShootSpec{
+						Provider: gardencorev1beta1.Provider{
+							Workers: []gardencorev1beta1.Worker{
+								{Name: "pool-1", Zones: []string{"zone-b", "zone-a"}},
+								{Name: "pool-2", Zones: []string{"zone-a"}},
+							},
+						},
+					},
+				},
 			}
 
-			_, err = vp.GetStorageClassesChartValues(ctx, nil, cluster)
-			Expect(err).To(MatchError("could not get resize policy from volumeclass : VolumeClass not found"))
+			values, err := vp.GetStorageClassesChartValues(ctx, nil, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(map[string]interface{}{
+				"storageClasses": []map[string]interface{}{
+					{
+						"name":       "foo",
+						"type":       "volume-static",
+						"default":    true,
+						"expandable": false,
+					},
+					{
+						"name":         "bar",
+						"type":         "volume-static",
+						"expandable":   false,
+						"allowedZones": []string{"zone-c"},
+					},
+				},
+			}))
+		})
+
+		It("should return the volume snapshot classes if volume snapshots are enabled", func(ctx SpecContext) {
+			providerCloudProfile := &apisironcore.CloudProfileConfig{
+				VolumeSnapshotClasses: []apisironcore.VolumeSnapshotClass{
+					{
+						Name:    "default",
+						Default: ptr.To(true),
+					},
+					{
+						Name:           "retain",
+						DeletionPolicy: ptr.To(apisironcore.VolumeSnapshotDeletionPolicyRetain),
+						Parameters:     map[string]string{"foo": "bar"},
+					},
+				},
+			}
+			providerCloudProfileJson, err := json.Marshal(providerCloudProfile)
+			Expect(err).NotTo(HaveOccurred())
+
+			cp := &extensionsv1alpha1.ControlPlane{
+				Spec: extensionsv1alpha1.ControlPlaneSpec{
+					DefaultSpec: extensionsv1alpha1.DefaultSpec{
+						ProviderConfig: &runtime.RawExtension{
+							Raw: encode(&apisironcore.ControlPlaneConfig{
+								Storage: &apisironcore.Storage{
+									VolumeSnapshots: &apisironcore.VolumeSnapshots{Enabled: true},
+								},
+							}),
+						},
+					},
+				},
+			}
+			cluster := &controller.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: ns.Name,
+				},
+				CloudProfile: &gardencorev1beta1.CloudProfile{
+					Spec: gardencorev1beta1.CloudProfileSpec{
+						ProviderConfig: &runtime.RawExtension{
+							Raw: providerCloudProfileJson,
+						},
+					},
+				},
+			}
+
+			values, err := vp.GetStorageClassesChartValues(ctx, cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(map[string]interface{}{
+				"storageClasses": []map[string]interface{}{},
+				"volumeSnapshotClasses": []map[string]interface{}{
+					{
+						"name":           "default",
+						"default":        true,
+						"deletionPolicy": "Delete",
+					},
+					{
+						"name":           "retain",
+						"default":        false,
+						"deletionPolicy": "Retain",
+						"parameters":     map[string]string{"foo": "bar"},
+					},
+				},
+			}))
 		})
 
 	})
@@ -400,6 +717,41 @@ var _ = Describe("Valueprovider Reconcile", func() {
 				},
 				"csi-driver-node": map[string]interface{}{
 					"enabled": true,
+					"volumeSnapshots": map[string]interface{}{
+						"enabled": false,
+					},
+				},
+			}))
+		})
+
+		It("should enable the volume snapshot components if configured in the controlplane config", func(ctx SpecContext) {
+			cp := &extensionsv1alpha1.ControlPlane{
+				Spec: extensionsv1alpha1.ControlPlaneSpec{
+					DefaultSpec: extensionsv1alpha1.DefaultSpec{
+						ProviderConfig: &runtime.RawExtension{
+							Raw: encode(&apisironcore.ControlPlaneConfig{
+								Storage: &apisironcore.Storage{
+									VolumeSnapshots: &apisironcore.VolumeSnapshots{Enabled: true},
+								},
+							}),
+						},
+					},
+				},
+			}
+			cluster := &controller.Cluster{
+				Shoot: &gardencorev1beta1.Shoot{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: ns.Name,
+						Name:      "my-shoot",
+					},
+				},
+			}
+			values, err := vp.GetControlPlaneShootChartValues(ctx, cp, cluster, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(HaveKeyWithValue("csi-driver-node", map[string]interface{}{
+				"enabled": true,
+				"volumeSnapshots": map[string]interface{}{
+					"enabled": true,
 				},
 			}))
 		})
@@ -441,6 +793,15 @@ var _ = Describe("Valueprovider Reconcile", func() {
 				},
 			}
 			providerCloudProfile := &apisironcore.CloudProfileConfig{
+				RegionConfigs: []apisironcore.RegionConfig{
+					{
+						Name: "foo",
+						Zones: []apisironcore.ZoneConfig{
+							{Name: "zone-a", VolumePoolName: ptr.To("pool-a")},
+							{Name: "zone-b"},
+						},
+					},
+				},
 				StorageClasses: apisironcore.StorageClasses{
 					Default: &apisironcore.StorageClass{
 						Name: "foo",
@@ -534,6 +895,13 @@ var _ = Describe("Valueprovider Reconcile", func() {
 				"csi-driver-controller": map[string]interface{}{
 					"enabled":  true,
 					"replicas": 1,
+					"region":   "foo",
+					"volumeSnapshots": map[string]interface{}{
+						"enabled": false,
+					},
+					"volumePools": map[string]interface{}{
+						"zone-a": "pool-a",
+					},
 				},
 			}))
 		})
@@ -657,10 +1025,30 @@ var _ = Describe("Valueprovider Reconcile", func() {
 				"csi-driver-controller": map[string]interface{}{
 					"enabled":  true,
 					"replicas": 1,
+					"region":   "foo",
+					"volumeSnapshots": map[string]interface{}{
+						"enabled": false,
+					},
 				},
 			}))
 		})
 	})
+
+	Describe("#getPodPrefixMode", func() {
+		It("should default to routed prefixes", func() {
+			Expect(getPodPrefixMode(&apisironcore.ControlPlaneConfig{})).To(Equal(apisironcore.PodPrefixModeRouted))
+		})
+
+		It("should return the configured pod prefix mode", func() {
+			Expect(getPodPrefixMode(&apisironcore.ControlPlaneConfig{
+				CloudControllerManager: &apisironcore.CloudControllerManagerConfig{
+					RouteController: &apisironcore.RouteControllerConfig{
+						PodPrefixMode: ptr.To(apisironcore.PodPrefixModeAlias),
+					},
+				},
+			})).To(Equal(apisironcore.PodPrefixModeAlias))
+		})
+	})
 })
 
 func encode(obj runtime.Object) []byte {
@@ -5,17 +5,22 @@ package infrastructure
 
 import (
 	"github.com/gardener/gardener/extensions/pkg/controller/infrastructure"
+	"k8s.io/client-go/tools/events"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/ironcore"
 )
 
 type actuator struct {
-	client client.Client
+	client   client.Client
+	recorder events.EventRecorder
 }
 
 // NewActuator creates a new infrastructure.Actuator.
 func NewActuator(mgr manager.Manager) infrastructure.Actuator {
 	return &actuator{
-		client: mgr.GetClient(),
+		client:   mgr.GetClient(),
+		recorder: mgr.GetEventRecorder(ironcore.ProviderName),
 	}
 }
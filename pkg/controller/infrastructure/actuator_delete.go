@@ -28,19 +28,19 @@ func (a *actuator) Delete(ctx context.Context, log logr.Logger, infra *extension
 		return fmt.Errorf("failed to get ironcore client and namespace from cloudprovider secret: %w", err)
 	}
 
-	if err := a.deletePrefix(ctx, ironcoreClient, namespace, cluster); client.IgnoreNotFound(err) != nil {
+	if err := a.deletePrefix(ctx, infra, ironcoreClient, namespace, cluster); client.IgnoreNotFound(err) != nil {
 		return fmt.Errorf("failed to delete infrastructure: %w", err)
 	}
 
-	if err := a.deleteNATGateway(ctx, ironcoreClient, namespace, cluster); client.IgnoreNotFound(err) != nil {
+	if err := a.deleteNATGateway(ctx, infra, ironcoreClient, namespace, cluster); client.IgnoreNotFound(err) != nil {
 		return fmt.Errorf("failed to delete infrastructure: %w", err)
 	}
 
-	if err := a.deleteNetworkPolicy(ctx, ironcoreClient, namespace, cluster); client.IgnoreNotFound(err) != nil {
+	if err := a.deleteNetworkPolicy(ctx, infra, ironcoreClient, namespace, cluster); client.IgnoreNotFound(err) != nil {
 		return fmt.Errorf("failed to delete infrastructure: %w", err)
 	}
 
-	if err := a.deleteNetwork(ctx, ironcoreClient, namespace, cluster); client.IgnoreNotFound(err) != nil {
+	if err := a.deleteNetwork(ctx, infra, ironcoreClient, namespace, cluster); client.IgnoreNotFound(err) != nil {
 		return fmt.Errorf("failed to delete infrastructure: %w", err)
 	}
 
@@ -52,42 +52,42 @@ func (a *actuator) ForceDelete(ctx context.Context, log logr.Logger, infra *exte
 	return a.Delete(ctx, log, infra, cluster)
 }
 
-func (a *actuator) deletePrefix(ctx context.Context, ironcoreClient client.Client, namespace string, cluster *extensionscontroller.Cluster) error {
+func (a *actuator) deletePrefix(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, ironcoreClient client.Client, namespace string, cluster *extensionscontroller.Cluster) error {
 	prefix := &ipamv1alpha1.Prefix{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace: namespace,
 			Name:      generateResourceNameFromCluster(cluster),
 		},
 	}
-	return ironcoreClient.Delete(ctx, prefix)
+	return ironcore.DeleteAndRecord(ctx, ironcoreClient, a.recorder, infra, prefix)
 }
 
-func (a *actuator) deleteNATGateway(ctx context.Context, ironcoreClient client.Client, namespace string, cluster *extensionscontroller.Cluster) error {
+func (a *actuator) deleteNATGateway(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, ironcoreClient client.Client, namespace string, cluster *extensionscontroller.Cluster) error {
 	natGateway := &networkingv1alpha1.NATGateway{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace: namespace,
 			Name:      generateResourceNameFromCluster(cluster),
 		},
 	}
-	return ironcoreClient.Delete(ctx, natGateway)
+	return ironcore.DeleteAndRecord(ctx, ironcoreClient, a.recorder, infra, natGateway)
 }
 
-func (a *actuator) deleteNetwork(ctx context.Context, ironcoreClient client.Client, namespace string, cluster *extensionscontroller.Cluster) error {
+func (a *actuator) deleteNetwork(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, ironcoreClient client.Client, namespace string, cluster *extensionscontroller.Cluster) error {
 	network := &networkingv1alpha1.Network{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace: namespace,
 			Name:      generateResourceNameFromCluster(cluster),
 		},
 	}
-	return ironcoreClient.Delete(ctx, network)
+	return ironcore.DeleteAndRecord(ctx, ironcoreClient, a.recorder, infra, network)
 }
 
-func (a *actuator) deleteNetworkPolicy(ctx context.Context, ironcoreClient client.Client, namespace string, cluster *extensionscontroller.Cluster) error {
+func (a *actuator) deleteNetworkPolicy(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, ironcoreClient client.Client, namespace string, cluster *extensionscontroller.Cluster) error {
 	networkPolicy := &networkingv1alpha1.NetworkPolicy{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace: namespace,
 			Name:      generateResourceNameFromCluster(cluster),
 		},
 	}
-	return ironcoreClient.Delete(ctx, networkPolicy)
+	return ironcore.DeleteAndRecord(ctx, ironcoreClient, a.recorder, infra, networkPolicy)
 }
@@ -51,22 +51,22 @@ func (a *actuator) reconcile(ctx context.Context, log logr.Logger, infra *extens
 		return fmt.Errorf("failed to get ironcore client and namespace from cloudprovider secret: %w", err)
 	}
 
-	network, err := a.applyNetwork(ctx, ironcoreClient, namespace, config, cluster)
+	network, err := a.applyNetwork(ctx, infra, ironcoreClient, namespace, config, cluster)
 	if err != nil {
 		return err
 	}
 
-	natGateway, err := a.applyNATGateway(ctx, config, ironcoreClient, namespace, cluster, network)
+	natGateway, err := a.applyNATGateway(ctx, infra, config, ironcoreClient, namespace, cluster, network)
 	if err != nil {
 		return err
 	}
 
-	prefix, err := a.applyPrefix(ctx, ironcoreClient, namespace, cluster)
+	prefix, err := a.applyPrefix(ctx, infra, ironcoreClient, namespace, cluster)
 	if err != nil {
 		return err
 	}
 
-	networkPolicy, err := a.applyNetworkPolicy(ctx, ironcoreClient, namespace, config, cluster, network)
+	networkPolicy, err := a.applyNetworkPolicy(ctx, infra, ironcoreClient, namespace, config, cluster, network)
 	if err != nil {
 		return err
 	}
@@ -77,7 +77,7 @@ func (a *actuator) reconcile(ctx context.Context, log logr.Logger, infra *extens
 	return a.updateProviderStatus(ctx, infra, network, natGateway, prefix, networkPolicy)
 }
 
-func (a *actuator) applyPrefix(ctx context.Context, ironcoreClient client.Client, namespace string, cluster *controller.Cluster) (*ipamv1alpha1.Prefix, error) {
+func (a *actuator) applyPrefix(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, ironcoreClient client.Client, namespace string, cluster *controller.Cluster) (*ipamv1alpha1.Prefix, error) {
 	prefix := &ipamv1alpha1.Prefix{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       "Prefix",
@@ -97,14 +97,16 @@ func (a *actuator) applyPrefix(ctx context.Context, ironcoreClient client.Client
 		prefix.Spec.Prefix = v1alpha1.MustParseNewIPPrefix(ptr.Deref[string](nodeCIDR, ""))
 	}
 
-	if _, err := controllerutil.CreateOrPatch(ctx, ironcoreClient, prefix, nil); err != nil {
+	result, err := controllerutil.CreateOrPatch(ctx, ironcoreClient, prefix, nil)
+	if err != nil {
 		return nil, fmt.Errorf("failed to apply prefix %s: %w", client.ObjectKeyFromObject(prefix), err)
 	}
+	ironcore.RecordApplyEvent(a.recorder, infra, prefix, result)
 
 	return prefix, nil
 }
 
-func (a *actuator) applyNATGateway(ctx context.Context, config *api.InfrastructureConfig, ironcoreClient client.Client, namespace string, cluster *controller.Cluster, network *networkingv1alpha1.Network) (*networkingv1alpha1.NATGateway, error) {
+func (a *actuator) applyNATGateway(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, config *api.InfrastructureConfig, ironcoreClient client.Client, namespace string, cluster *controller.Cluster, network *networkingv1alpha1.Network) (*networkingv1alpha1.NATGateway, error) {
 
 	natGateway := &networkingv1alpha1.NATGateway{
 		TypeMeta: metav1.TypeMeta{
@@ -125,6 +127,7 @@ func (a *actuator) applyNATGateway(ctx context.Context, config *api.Infrastructu
 		},
 	}
 
+	var natPortsAdjustment string
 	if portsPerNetworkInterface := config.NATPortsPerNetworkInterface; natGateway.Spec.IPFamily == corev1.IPv4Protocol && portsPerNetworkInterface != nil {
 		if nodeCIDR := cluster.Shoot.Spec.Networking.Nodes; nodeCIDR != nil {
 			_, ipv4Net, err := net.ParseCIDR(*nodeCIDR)
@@ -145,12 +148,20 @@ func (a *actuator) applyNATGateway(ctx context.Context, config *api.Infrastructu
 			} else {
 				natGateway.Spec.PortsPerNetworkInterface = ptr.To(previousPowOf2(int32(ports.Int64())))
 			}
+			if adjusted := *natGateway.Spec.PortsPerNetworkInterface; adjusted != *portsPerNetworkInterface {
+				natPortsAdjustment = fmt.Sprintf("using %d instead of the configured %d NAT ports per network interface for node CIDR %s", adjusted, *portsPerNetworkInterface, *nodeCIDR)
+			}
 		}
 	}
 
-	if _, err := controllerutil.CreateOrPatch(ctx, ironcoreClient, natGateway, nil); err != nil {
+	result, err := controllerutil.CreateOrPatch(ctx, ironcoreClient, natGateway, nil)
+	if err != nil {
 		return nil, fmt.Errorf("failed to apply natgateway %s: %w", client.ObjectKeyFromObject(natGateway), err)
 	}
+	ironcore.RecordApplyEvent(a.recorder, infra, natGateway, result)
+	if natPortsAdjustment != "" {
+		ironcore.RecordConfigurationAdjustedEvent(a.recorder, infra, natGateway, "%s", natPortsAdjustment)
+	}
 	return natGateway, nil
 }
 
@@ -163,7 +174,7 @@ func previousPowOf2(n int32) int32 {
 	return n - (n >> 1)
 }
 
-func (a *actuator) applyNetwork(ctx context.Context, ironcoreClient client.Client, namespace string, config *api.InfrastructureConfig, cluster *controller.Cluster) (*networkingv1alpha1.Network, error) {
+func (a *actuator) applyNetwork(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, ironcoreClient client.Client, namespace string, config *api.InfrastructureConfig, cluster *controller.Cluster) (*networkingv1alpha1.Network, error) {
 	if config != nil && config.NetworkRef != nil {
 		network := &networkingv1alpha1.Network{}
 		networkKey := client.ObjectKey{Namespace: namespace, Name: config.NetworkRef.Name}
@@ -184,13 +195,15 @@ func (a *actuator) applyNetwork(ctx context.Context, ironcoreClient client.Clien
 		},
 	}
 
-	if _, err := controllerutil.CreateOrPatch(ctx, ironcoreClient, network, nil); err != nil {
+	result, err := controllerutil.CreateOrPatch(ctx, ironcoreClient, network, nil)
+	if err != nil {
 		return nil, fmt.Errorf("failed to apply network %s: %w", client.ObjectKeyFromObject(network), err)
 	}
+	ironcore.RecordApplyEvent(a.recorder, infra, network, result)
 	return network, nil
 }
 
-func (a *actuator) applyNetworkPolicy(ctx context.Context, ironcoreClient client.Client, namespace string, config *api.InfrastructureConfig, cluster *controller.Cluster, network *networkingv1alpha1.Network) (*networkingv1alpha1.NetworkPolicy, error) {
+func (a *actuator) applyNetworkPolicy(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, ironcoreClient client.Client, namespace string, config *api.InfrastructureConfig, cluster *controller.Cluster, network *networkingv1alpha1.Network) (*networkingv1alpha1.NetworkPolicy, error) {
 	if config != nil && config.NetworkPolicyRef != nil {
 		networkPolicy := &networkingv1alpha1.NetworkPolicy{}
 		networkKey := client.ObjectKey{Namespace: namespace, Name: config.NetworkRef.Name}
@@ -227,9 +240,11 @@ func (a *actuator) applyNetworkPolicy(ctx context.Context, ironcoreClient client
 		},
 	}
 
-	if _, err := controllerutil.CreateOrPatch(ctx, ironcoreClient, networkPolicy, nil); err != nil {
+	result, err := controllerutil.CreateOrPatch(ctx, ironcoreClient, networkPolicy, nil)
+	if err != nil {
 		return nil, fmt.Errorf("failed to apply network policy %s: %w", client.ObjectKeyFromObject(networkPolicy), err)
 	}
+	ironcore.RecordApplyEvent(a.recorder, infra, networkPolicy, result)
 	return networkPolicy, nil
 }
 
@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package infrastructure
+
+import (
+	"context"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	"github.com/gardener/gardener/extensions/pkg/controller/infrastructure"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+
+	"github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/metrics"
+)
+
+// metricsActuator records the duration and the result of the operations of the wrapped infrastructure actuator.
+type metricsActuator struct {
+	infrastructure.Actuator
+}
+
+func (a *metricsActuator) Reconcile(ctx context.Context, log logr.Logger, infra *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) error {
+	return metrics.ObserveActuator(infrastructure.ControllerName, metrics.OperationReconcile, func() error {
+		return a.Actuator.Reconcile(ctx, log, infra, cluster)
+	})
+}
+
+func (a *metricsActuator) Delete(ctx context.Context, log logr.Logger, infra *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) error {
+	return metrics.ObserveActuator(infrastructure.ControllerName, metrics.OperationDelete, func() error {
+		return a.Actuator.Delete(ctx, log, infra, cluster)
+	})
+}
+
+func (a *metricsActuator) ForceDelete(ctx context.Context, log logr.Logger, infra *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) error {
+	return metrics.ObserveActuator(infrastructure.ControllerName, metrics.OperationForceDelete, func() error {
+		return a.Actuator.ForceDelete(ctx, log, infra, cluster)
+	})
+}
+
+func (a *metricsActuator) Restore(ctx context.Context, log logr.Logger, infra *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) error {
+	return metrics.ObserveActuator(infrastructure.ControllerName, metrics.OperationRestore, func() error {
+		return a.Actuator.Restore(ctx, log, infra, cluster)
+	})
+}
+
+func (a *metricsActuator) Migrate(ctx context.Context, log logr.Logger, infra *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) error {
+	return metrics.ObserveActuator(infrastructure.ControllerName, metrics.OperationMigrate, func() error {
+		return a.Actuator.Migrate(ctx, log, infra, cluster)
+	})
+}
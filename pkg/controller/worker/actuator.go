@@ -10,16 +10,19 @@ import (
 	"github.com/gardener/gardener/extensions/pkg/controller/worker"
 	"github.com/gardener/gardener/extensions/pkg/controller/worker/genericactuator"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/events"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/cluster"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	api "github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/apis/ironcore"
 	"github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/apis/ironcore/helper"
+	"github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/ironcore"
 )
 
 type delegateFactory struct {
@@ -28,6 +31,7 @@ type delegateFactory struct {
 	decoder      runtime.Decoder
 	restConfig   *rest.Config
 	scheme       *runtime.Scheme
+	recorder     events.EventRecorder
 }
 
 type actuator struct {
@@ -43,6 +47,7 @@ func NewActuator(mgr manager.Manager, gardenCluster cluster.Cluster) worker.Actu
 		decoder:      serializer.NewCodecFactory(mgr.GetScheme(), serializer.EnableStrict).UniversalDecoder(),
 		restConfig:   mgr.GetConfig(),
 		scheme:       mgr.GetScheme(),
+		recorder:     mgr.GetEventRecorder(ironcore.ProviderName),
 	}
 
 	return &actuator{
@@ -66,6 +71,7 @@ func (d *delegateFactory) WorkerDelegate(_ context.Context, worker *extensionsv1
 		d.seedClient,
 		d.decoder,
 		d.scheme,
+		d.recorder,
 		serverVersion.GitVersion,
 		worker,
 		cluster,
@@ -73,14 +79,21 @@ func (d *delegateFactory) WorkerDelegate(_ context.Context, worker *extensionsv1
 }
 
 type workerDelegate struct {
-	client  client.Client
-	decoder runtime.Decoder
-	scheme  *runtime.Scheme
+	client   client.Client
+	decoder  runtime.Decoder
+	scheme   *runtime.Scheme
+	recorder events.EventRecorder
 
 	serverVersion      string
 	cloudProfileConfig *api.CloudProfileConfig
 	cluster            *extensionscontroller.Cluster
 	worker             *extensionsv1alpha1.Worker
+
+	// ironcoreClient, ironcoreNamespace and machineClassCapacities are filled lazily and live as long as the delegate,
+	// i.e. for a single reconciliation of the worker.
+	ironcoreClient         client.Client
+	ironcoreNamespace      string
+	machineClassCapacities map[string]corev1.ResourceList
 }
 
 // NewWorkerDelegate creates a new context for a worker reconciliation.
@@ -88,6 +101,7 @@ func NewWorkerDelegate(
 	client client.Client,
 	decoder runtime.Decoder,
 	scheme *runtime.Scheme,
+	recorder events.EventRecorder,
 	serverVersion string,
 	worker *extensionsv1alpha1.Worker,
 	cluster *extensionscontroller.Cluster,
@@ -102,11 +116,14 @@ func NewWorkerDelegate(
 
 	return &workerDelegate{
 		scheme:             scheme,
+		recorder:           recorder,
 		client:             client,
 		decoder:            decoder,
 		serverVersion:      serverVersion,
 		cloudProfileConfig: config,
 		cluster:            cluster,
 		worker:             worker,
+
+		machineClassCapacities: map[string]corev1.ResourceList{},
 	}, nil
 }
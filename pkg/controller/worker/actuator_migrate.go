@@ -0,0 +1,47 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"context"
+	"fmt"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+)
+
+// machineStateDelegate carries the state of the ironcore machines of a worker over a control plane migration.
+type machineStateDelegate interface {
+	PersistMachineState(ctx context.Context) error
+	RestoreMachineState(ctx context.Context) error
+	CleanupMachineState(ctx context.Context) error
+}
+
+func (a *actuator) machineStateDelegate(ctx context.Context, worker *extensionsv1alpha1.Worker, cluster *extensionscontroller.Cluster) (machineStateDelegate, error) {
+	delegate, err := a.workerDelegate.WorkerDelegate(ctx, worker, cluster)
+	if err != nil {
+		return nil, fmt.Errorf("could not instantiate worker delegate: %w", err)
+	}
+	machineStateDelegate, ok := delegate.(machineStateDelegate)
+	if !ok {
+		return nil, fmt.Errorf("worker delegate %T does not support machine state", delegate)
+	}
+	return machineStateDelegate, nil
+}
+
+// Migrate records the ironcore resources of the machines of the worker before it is migrated to another seed.
+func (a *actuator) Migrate(ctx context.Context, log logr.Logger, worker *extensionsv1alpha1.Worker, cluster *extensionscontroller.Cluster) error {
+	delegate, err := a.machineStateDelegate(ctx, worker, cluster)
+	if err != nil {
+		return err
+	}
+
+	log.Info("Persisting machine state")
+	if err := delegate.PersistMachineState(ctx); err != nil {
+		return fmt.Errorf("failed to persist machine state: %w", err)
+	}
+
+	return a.Actuator.Migrate(ctx, log, worker, cluster)
+}
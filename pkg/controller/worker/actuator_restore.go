@@ -0,0 +1,33 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"context"
+	"fmt"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+)
+
+// Restore restores the machine state recorded by Migrate before the machines are restored, so that the
+// machine-controller-manager adopts the existing ironcore machines instead of replacing the nodes.
+func (a *actuator) Restore(ctx context.Context, log logr.Logger, worker *extensionsv1alpha1.Worker, cluster *extensionscontroller.Cluster) error {
+	delegate, err := a.machineStateDelegate(ctx, worker, cluster)
+	if err != nil {
+		return err
+	}
+
+	log.Info("Restoring machine state")
+	if err := delegate.RestoreMachineState(ctx); err != nil {
+		return fmt.Errorf("failed to restore machine state: %w", err)
+	}
+
+	if err := a.Actuator.Restore(ctx, log, worker, cluster); err != nil {
+		return err
+	}
+
+	return delegate.CleanupMachineState(ctx)
+}
@@ -0,0 +1,119 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+
+	apiv1alpha1 "github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/apis/ironcore/v1alpha1"
+)
+
+const (
+	// defaultMaxPods is the upper bound for the number of pods of a node derived from its MachineClass.
+	defaultMaxPods int32 = 110
+	// minMaxPods is the lower bound for the number of pods of a node derived from its MachineClass.
+	minMaxPods int32 = 32
+	// maxPodsPerCPU is the number of pods which are admitted per CPU of a MachineClass.
+	maxPodsPerCPU int32 = 16
+)
+
+// generateKubeletConfigs returns the kubelet configurations derived from the ironcore MachineClasses of the worker
+// pools which enable KubeletConfigFromMachineClass. Pools whose MachineClass cannot be read are left out, so that their
+// nodes keep the unmodified kubelet configuration.
+func (w *workerDelegate) generateKubeletConfigs(ctx context.Context) ([]apiv1alpha1.WorkerPoolKubeletConfig, error) {
+	var kubeletConfigs []apiv1alpha1.WorkerPoolKubeletConfig
+	for _, pool := range w.worker.Spec.Pools {
+		workerConfig, err := w.decodeWorkerConfig(pool)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode worker config of worker pool %s: %w", pool.Name, err)
+		}
+		if !ptr.Deref(workerConfig.KubeletConfigFromMachineClass, false) {
+			continue
+		}
+
+		capacity, ok := w.getMachineClassCapacity(ctx, pool.MachineType)
+		if !ok {
+			continue
+		}
+		kubeletConfigs = append(kubeletConfigs, kubeletConfigForCapacity(pool.Name, capacity))
+	}
+	return kubeletConfigs, nil
+}
+
+// kubeletConfigForCapacity derives the kube reserved resources and the max pods of the nodes of a worker pool from the
+// capacity of its MachineClass.
+func kubeletConfigForCapacity(poolName string, capacity corev1.ResourceList) apiv1alpha1.WorkerPoolKubeletConfig {
+	config := apiv1alpha1.WorkerPoolKubeletConfig{PoolName: poolName}
+	if cpu, ok := capacity[corev1.ResourceCPU]; ok && !cpu.IsZero() {
+		config.KubeReserved = appendResource(config.KubeReserved, corev1.ResourceCPU, reservedCPU(&cpu))
+		config.MaxPods = ptr.To(min(max(minMaxPods, int32(cpu.Value())*maxPodsPerCPU), defaultMaxPods))
+	}
+	if memory, ok := capacity[corev1.ResourceMemory]; ok && !memory.IsZero() {
+		config.KubeReserved = appendResource(config.KubeReserved, corev1.ResourceMemory, reservedMemory(&memory))
+	}
+	return config
+}
+
+func appendResource(resources corev1.ResourceList, name corev1.ResourceName, quantity *resource.Quantity) corev1.ResourceList {
+	if resources == nil {
+		resources = corev1.ResourceList{}
+	}
+	resources[name] = *quantity
+	return resources
+}
+
+// reservedCPU returns the CPU reserved for kubernetes node components: 6% of the first core, 1% of the second core,
+// 0.5% of the next two cores and 0.25% of all further cores.
+func reservedCPU(cpu *resource.Quantity) *resource.Quantity {
+	reserved := tieredReservation(cpu.MilliValue(), []reservationTier{
+		{size: 1000, permille: 60},
+		{size: 1000, permille: 10},
+		{size: 2000, permille: 5},
+		{permille: 2.5},
+	})
+	return resource.NewMilliQuantity(reserved, resource.DecimalSI)
+}
+
+// reservedMemory returns the memory reserved for kubernetes node components: 25% of the first 4Gi, 20% of the next
+// 4Gi, 10% of the next 8Gi, 6% of the next 112Gi and 2% of all further memory.
+func reservedMemory(memory *resource.Quantity) *resource.Quantity {
+	const gi = 1024 * 1024 * 1024
+	reserved := tieredReservation(memory.Value(), []reservationTier{
+		{size: 4 * gi, permille: 250},
+		{size: 4 * gi, permille: 200},
+		{size: 8 * gi, permille: 100},
+		{size: 112 * gi, permille: 60},
+		{permille: 20},
+	})
+	// Round down to full Mi to keep the kubelet configuration readable.
+	reserved = reserved / (1024 * 1024) * (1024 * 1024)
+	return resource.NewQuantity(reserved, resource.BinarySI)
+}
+
+type reservationTier struct {
+	// size is the amount of the tier, zero means unbounded.
+	size     int64
+	permille float64
+}
+
+func tieredReservation(total int64, tiers []reservationTier) int64 {
+	var reserved float64
+	for _, tier := range tiers {
+		if total <= 0 {
+			break
+		}
+		amount := total
+		if tier.size > 0 {
+			amount = min(total, tier.size)
+		}
+		reserved += float64(amount) * tier.permille / 1000
+		total -= amount
+	}
+	return int64(reserved)
+}
@@ -20,6 +20,7 @@ import (
 
 // UpdateMachineImagesStatus updates the machine image status
 // with the used machine images for the `Worker` resource.
+// It also records the kubelet configurations derived from the ironcore MachineClasses of the worker pools.
 func (w *workerDelegate) UpdateMachineImagesStatus(ctx context.Context) error {
 	var machineImages []apiv1alpha1.MachineImage
 	for _, pool := range w.worker.Spec.Pools {
@@ -38,6 +39,11 @@ func (w *workerDelegate) UpdateMachineImagesStatus(ctx context.Context) error {
 		})
 	}
 
+	kubeletConfigs, err := w.generateKubeletConfigs(ctx)
+	if err != nil {
+		return err
+	}
+
 	// Decode the current worker provider status.
 	workerStatus, err := w.decodeWorkerProviderStatus()
 	if err != nil {
@@ -45,6 +51,7 @@ func (w *workerDelegate) UpdateMachineImagesStatus(ctx context.Context) error {
 	}
 
 	workerStatus.MachineImages = machineImages
+	workerStatus.KubeletConfigs = kubeletConfigs
 
 	return w.updateWorkerProviderStatus(ctx, workerStatus)
 }
@@ -4,13 +4,19 @@
 package worker
 
 import (
+	gardenerextensionv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	commonv1alpha1 "github.com/ironcore-dev/ironcore/api/common/v1alpha1"
+	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	corev1alpha1 "github.com/ironcore-dev/ironcore/api/core/v1alpha1"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -39,7 +45,7 @@ var _ = Describe("MachinesImages", func() {
 
 		By("creating a worker delegate")
 		decoder := serializer.NewCodecFactory(k8sClient.Scheme(), serializer.EnableStrict).UniversalDecoder()
-		workerDelegate, err := NewWorkerDelegate(k8sClient, decoder, k8sClient.Scheme(), "", w, testCluster)
+		workerDelegate, err := NewWorkerDelegate(k8sClient, decoder, k8sClient.Scheme(), &events.FakeRecorder{}, "", w, testCluster)
 		Expect(err).NotTo(HaveOccurred())
 
 		By("calling the updating machine image status")
@@ -75,4 +81,60 @@ var _ = Describe("MachinesImages", func() {
 			g.Expect(workerStatus).To(Equal(expectedWorkerStatus))
 		}).Should(Succeed())
 	})
+
+	It("should record the kubelet configuration derived from the machine class of a worker pool", func(ctx SpecContext) {
+		By("providing an ironcore machine class for the machine type of the pool")
+		ironcoreMachineClass := &computev1alpha1.MachineClass{
+			ObjectMeta: metav1.ObjectMeta{Name: pool.MachineType},
+			Capabilities: corev1alpha1.ResourceList{
+				corev1alpha1.ResourceCPU:    resource.MustParse("4"),
+				corev1alpha1.ResourceMemory: resource.MustParse("16Gi"),
+			},
+		}
+		Expect(k8sClient.Create(ctx, ironcoreMachineClass)).To(Succeed())
+		DeferCleanup(k8sClient.Delete, ironcoreMachineClass)
+
+		By("enabling the kubelet configuration from the machine class for a pool with and a pool without machine class")
+		workerConfig := &runtime.RawExtension{Raw: encodeObject(&apiv1alpha1.WorkerConfig{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+				Kind:       "WorkerConfig",
+			},
+			KubeletConfigFromMachineClass: ptr.To(true),
+		})}
+		derivedPool := pool
+		derivedPool.ProviderConfig = workerConfig
+		unknownPool := pool
+		unknownPool.Name = "unknown"
+		unknownPool.MachineType = "unknown"
+		unknownPool.ProviderConfig = workerConfig
+		w.Spec.Pools = []gardenerextensionv1alpha1.WorkerPool{derivedPool, unknownPool}
+		w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{Object: &apiv1alpha1.InfrastructureStatus{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+				Kind:       "InfrastructureStatus",
+			},
+		}}
+		Expect(k8sClient.Create(ctx, w)).To(Succeed())
+
+		By("updating the worker status")
+		decoder := serializer.NewCodecFactory(k8sClient.Scheme(), serializer.EnableStrict).UniversalDecoder()
+		workerDelegate, err := NewWorkerDelegate(k8sClient, decoder, k8sClient.Scheme(), &events.FakeRecorder{}, "", w, testCluster)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(workerDelegate.UpdateMachineImagesStatus(ctx)).To(Succeed())
+
+		By("ensuring that only the kubelet configuration of the pool with machine class has been recorded")
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(w), w)).To(Succeed())
+		workerStatus := &apiv1alpha1.WorkerStatus{}
+		_, _, err = decoder.Decode(w.Status.ProviderStatus.Raw, nil, workerStatus)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(workerStatus.KubeletConfigs).To(BeComparableTo([]apiv1alpha1.WorkerPoolKubeletConfig{{
+			PoolName: pool.Name,
+			KubeReserved: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("80m"),
+				corev1.ResourceMemory: resource.MustParse("2662Mi"),
+			},
+			MaxPods: ptr.To[int32](64),
+		}}))
+	})
 })
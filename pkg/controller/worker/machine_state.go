@@ -0,0 +1,279 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	commonv1alpha1 "github.com/ironcore-dev/ironcore/api/common/v1alpha1"
+	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	networkingv1alpha1 "github.com/ironcore-dev/ironcore/api/networking/v1alpha1"
+	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	apiv1alpha1 "github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/apis/ironcore/v1alpha1"
+	"github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/ironcore"
+)
+
+const (
+	// machineStateResourceName is the name of the resource reference of the Worker to the machine state ConfigMap.
+	machineStateResourceName = "machine-state"
+	// machineStateDataKey is the key of the WorkerStatus in the machine state ConfigMap.
+	machineStateDataKey = "workerStatus"
+)
+
+// PersistMachineState records the ironcore resources of the machines of the worker in its provider status. The
+// provider status is not part of the ShootState, hence it is additionally stored in a ConfigMap which is referenced in
+// the resources of the Worker. Gardener persists referenced resources and recreates them on the destination seed.
+func (w *workerDelegate) PersistMachineState(ctx context.Context) error {
+	machines, err := w.getMachineStates(ctx)
+	if err != nil {
+		return err
+	}
+
+	workerStatus, err := w.decodeWorkerProviderStatus()
+	if err != nil {
+		return fmt.Errorf("unable to decode the worker provider status: %w", err)
+	}
+	workerStatus.Machines = machines
+
+	data, err := encodeWorkerProviderStatus(workerStatus)
+	if err != nil {
+		return err
+	}
+
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      machineStateConfigMapName(w.worker.Name),
+			Namespace: w.worker.Namespace,
+		},
+	}
+	if _, err := controllerutil.CreateOrUpdate(ctx, w.client, configMap, func() error {
+		configMap.Data = map[string]string{machineStateDataKey: string(data)}
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed to create or update machine state config map %s: %w", client.ObjectKeyFromObject(configMap), err)
+	}
+
+	patch := client.MergeFrom(w.worker.DeepCopy())
+	w.worker.Status.ProviderStatus = &runtime.RawExtension{Raw: data}
+	w.worker.Status.Resources = slices.DeleteFunc(w.worker.Status.Resources, isMachineStateResource)
+	w.worker.Status.Resources = append(w.worker.Status.Resources, gardencorev1beta1.NamedResourceReference{
+		Name: machineStateResourceName,
+		ResourceRef: autoscalingv1.CrossVersionObjectReference{
+			APIVersion: "v1",
+			Kind:       "ConfigMap",
+			Name:       configMap.Name,
+		},
+	})
+	return w.client.Status().Patch(ctx, w.worker, patch)
+}
+
+// RestoreMachineState restores the provider status which was recorded by PersistMachineState on the source seed. It
+// fails if an ironcore resource of a recorded machine has been replaced since, as the restored machine would otherwise
+// adopt a resource it was not created with. Machines whose ironcore Machine is gone are recreated by the
+// machine-controller-manager.
+func (w *workerDelegate) RestoreMachineState(ctx context.Context) error {
+	idx := slices.IndexFunc(w.worker.Status.Resources, isMachineStateResource)
+	if idx < 0 {
+		return nil
+	}
+
+	configMap := &corev1.ConfigMap{}
+	configMapKey := client.ObjectKey{Namespace: w.worker.Namespace, Name: w.worker.Status.Resources[idx].ResourceRef.Name}
+	if err := w.client.Get(ctx, configMapKey, configMap); err != nil {
+		return fmt.Errorf("failed to get machine state config map %s: %w", configMapKey, err)
+	}
+
+	data := []byte(configMap.Data[machineStateDataKey])
+	workerStatus := &apiv1alpha1.WorkerStatus{}
+	if _, _, err := w.decoder.Decode(data, nil, workerStatus); err != nil {
+		return fmt.Errorf("could not decode machine state of config map %s: %w", configMapKey, err)
+	}
+
+	patch := client.MergeFrom(w.worker.DeepCopy())
+	w.worker.Status.ProviderStatus = &runtime.RawExtension{Raw: data}
+	if err := w.client.Status().Patch(ctx, w.worker, patch); err != nil {
+		return err
+	}
+
+	return w.verifyMachineStates(ctx, workerStatus.Machines)
+}
+
+// CleanupMachineState removes the machine state once the worker has been restored. It goes stale as soon as the
+// machines of the worker are rolled.
+func (w *workerDelegate) CleanupMachineState(ctx context.Context) error {
+	idx := slices.IndexFunc(w.worker.Status.Resources, isMachineStateResource)
+	if idx < 0 {
+		return nil
+	}
+
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      w.worker.Status.Resources[idx].ResourceRef.Name,
+			Namespace: w.worker.Namespace,
+		},
+	}
+	if err := client.IgnoreNotFound(w.client.Delete(ctx, configMap)); err != nil {
+		return fmt.Errorf("failed to delete machine state config map %s: %w", client.ObjectKeyFromObject(configMap), err)
+	}
+
+	workerStatus, err := w.decodeWorkerProviderStatus()
+	if err != nil {
+		return fmt.Errorf("unable to decode the worker provider status: %w", err)
+	}
+	workerStatus.Machines = nil
+
+	data, err := encodeWorkerProviderStatus(workerStatus)
+	if err != nil {
+		return err
+	}
+
+	patch := client.MergeFrom(w.worker.DeepCopy())
+	w.worker.Status.ProviderStatus = &runtime.RawExtension{Raw: data}
+	w.worker.Status.Resources = slices.DeleteFunc(w.worker.Status.Resources, isMachineStateResource)
+	return w.client.Status().Patch(ctx, w.worker, patch)
+}
+
+// verifyMachineStates compares the given recorded machines with the current ironcore resources of the worker.
+func (w *workerDelegate) verifyMachineStates(ctx context.Context, recordedMachines []apiv1alpha1.MachineState) error {
+	if len(recordedMachines) == 0 {
+		return nil
+	}
+
+	machines, err := w.getMachineStates(ctx)
+	if err != nil {
+		return err
+	}
+	currentMachines := make(map[string]apiv1alpha1.MachineState, len(machines))
+	for _, machine := range machines {
+		currentMachines[machine.Name] = machine
+	}
+
+	var replaced []string
+	for _, recorded := range recordedMachines {
+		current, ok := currentMachines[recorded.Name]
+		if !ok {
+			continue
+		}
+		if current.UID != recorded.UID {
+			replaced = append(replaced, fmt.Sprintf("machine %s", recorded.Name))
+			continue
+		}
+		replaced = append(replaced, replacedRefs("network interface", recorded.NetworkInterfaceRefs, current.NetworkInterfaceRefs)...)
+		replaced = append(replaced, replacedRefs("volume", recorded.VolumeRefs, current.VolumeRefs)...)
+	}
+
+	if len(replaced) > 0 {
+		return fmt.Errorf("ironcore resources of the worker have been replaced since it was migrated, refusing to adopt them: %s",
+			strings.Join(replaced, ", "))
+	}
+	return nil
+}
+
+func replacedRefs(kind string, recordedRefs, currentRefs []commonv1alpha1.LocalUIDReference) []string {
+	var replaced []string
+	for _, recorded := range recordedRefs {
+		for _, current := range currentRefs {
+			if current.Name == recorded.Name && current.UID != recorded.UID {
+				replaced = append(replaced, fmt.Sprintf("%s %s", kind, recorded.Name))
+			}
+		}
+	}
+	return replaced
+}
+
+// getMachineStates returns the ironcore Machines of the cluster together with their NetworkInterfaces and Volumes,
+// sorted by name.
+func (w *workerDelegate) getMachineStates(ctx context.Context) ([]apiv1alpha1.MachineState, error) {
+	ironcoreClient, namespace, err := w.getIroncoreClientAndNamespace(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	machineList := &computev1alpha1.MachineList{}
+	if err := ironcoreClient.List(ctx, machineList, client.InNamespace(namespace), client.MatchingLabels{
+		ironcore.ClusterNameLabel: w.cluster.ObjectMeta.Name,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to list ironcore machines: %w", err)
+	}
+	if len(machineList.Items) == 0 {
+		return nil, nil
+	}
+
+	networkInterfaceList := &networkingv1alpha1.NetworkInterfaceList{}
+	if err := ironcoreClient.List(ctx, networkInterfaceList, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list ironcore network interfaces: %w", err)
+	}
+	networkInterfaceUIDs := make(map[string]types.UID, len(networkInterfaceList.Items))
+	for _, networkInterface := range networkInterfaceList.Items {
+		networkInterfaceUIDs[networkInterface.Name] = networkInterface.UID
+	}
+
+	volumeList := &storagev1alpha1.VolumeList{}
+	if err := ironcoreClient.List(ctx, volumeList, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list ironcore volumes: %w", err)
+	}
+	volumeUIDs := make(map[string]types.UID, len(volumeList.Items))
+	for _, volume := range volumeList.Items {
+		volumeUIDs[volume.Name] = volume.UID
+	}
+
+	machines := make([]apiv1alpha1.MachineState, 0, len(machineList.Items))
+	for _, machine := range machineList.Items {
+		machines = append(machines, apiv1alpha1.MachineState{
+			Name:                 machine.Name,
+			UID:                  machine.UID,
+			NetworkInterfaceRefs: uidRefs(computev1alpha1.MachineNetworkInterfaceNames(&machine), networkInterfaceUIDs),
+			VolumeRefs:           uidRefs(computev1alpha1.MachineVolumeNames(&machine), volumeUIDs),
+		})
+	}
+	slices.SortFunc(machines, func(a, b apiv1alpha1.MachineState) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+	return machines, nil
+}
+
+// uidRefs returns references to the objects with the given names, objects which do not exist are skipped.
+func uidRefs(names []string, uids map[string]types.UID) []commonv1alpha1.LocalUIDReference {
+	var refs []commonv1alpha1.LocalUIDReference
+	for _, name := range names {
+		if uid, ok := uids[name]; ok {
+			refs = append(refs, commonv1alpha1.LocalUIDReference{Name: name, UID: uid})
+		}
+	}
+	return refs
+}
+
+func encodeWorkerProviderStatus(workerStatus *apiv1alpha1.WorkerStatus) ([]byte, error) {
+	workerStatus.TypeMeta = metav1.TypeMeta{
+		APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+		Kind:       "WorkerStatus",
+	}
+	data, err := json.Marshal(workerStatus)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal worker provider status: %w", err)
+	}
+	return data, nil
+}
+
+func isMachineStateResource(resource gardencorev1beta1.NamedResourceReference) bool {
+	return resource.Name == machineStateResourceName
+}
+
+func machineStateConfigMapName(workerName string) string {
+	return fmt.Sprintf("%s-machine-state", workerName)
+}
@@ -0,0 +1,223 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"context"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	commonv1alpha1 "github.com/ironcore-dev/ironcore/api/common/v1alpha1"
+	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	networkingv1alpha1 "github.com/ironcore-dev/ironcore/api/networking/v1alpha1"
+	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	. "sigs.k8s.io/controller-runtime/pkg/envtest/komega"
+
+	apiv1alpha1 "github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/apis/ironcore/v1alpha1"
+	"github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/ironcore"
+)
+
+var _ = Describe("MachineState", func() {
+	ns, _ := SetupTest()
+
+	var (
+		machine          *computev1alpha1.Machine
+		networkInterface *networkingv1alpha1.NetworkInterface
+		volume           *storagev1alpha1.Volume
+		decoder          runtime.Decoder
+	)
+
+	BeforeEach(func(ctx SpecContext) {
+		testCluster.ObjectMeta.Name = ns.Name
+
+		networkInterface = &networkingv1alpha1.NetworkInterface{
+			ObjectMeta: metav1.ObjectMeta{Namespace: ns.Name, Name: "machine-0-primary"},
+			Spec: networkingv1alpha1.NetworkInterfaceSpec{
+				NetworkRef: corev1.LocalObjectReference{Name: "my-network"},
+				IPFamilies: []corev1.IPFamily{corev1.IPv4Protocol},
+				IPs:        []networkingv1alpha1.IPSource{{Value: commonv1alpha1.MustParseNewIP("10.0.0.1")}},
+			},
+		}
+		Expect(k8sClient.Create(ctx, networkInterface)).To(Succeed())
+		DeferCleanup(client.IgnoreNotFound, k8sClient.Delete, networkInterface)
+
+		volume = &storagev1alpha1.Volume{
+			ObjectMeta: metav1.ObjectMeta{Namespace: ns.Name, Name: "machine-0-root"},
+		}
+		Expect(k8sClient.Create(ctx, volume)).To(Succeed())
+		DeferCleanup(k8sClient.Delete, volume)
+
+		machine = &computev1alpha1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns.Name,
+				Name:      "machine-0",
+				Labels:    map[string]string{ironcore.ClusterNameLabel: ns.Name},
+			},
+			Spec: computev1alpha1.MachineSpec{
+				MachineClassRef: corev1.LocalObjectReference{Name: "foo"},
+				NetworkInterfaces: []computev1alpha1.NetworkInterface{{
+					Name: "primary",
+					NetworkInterfaceSource: computev1alpha1.NetworkInterfaceSource{
+						NetworkInterfaceRef: &corev1.LocalObjectReference{Name: networkInterface.Name},
+					},
+				}},
+				Volumes: []computev1alpha1.Volume{{
+					Name: "root",
+					VolumeSource: computev1alpha1.VolumeSource{
+						VolumeRef: &corev1.LocalObjectReference{Name: volume.Name},
+					},
+				}},
+			},
+		}
+		Expect(k8sClient.Create(ctx, machine)).To(Succeed())
+		DeferCleanup(client.IgnoreNotFound, k8sClient.Delete, machine)
+
+		otherMachine := &computev1alpha1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns.Name,
+				Name:      "other-machine",
+				Labels:    map[string]string{ironcore.ClusterNameLabel: "other-cluster"},
+			},
+			Spec: computev1alpha1.MachineSpec{
+				MachineClassRef: corev1.LocalObjectReference{Name: "foo"},
+			},
+		}
+		Expect(k8sClient.Create(ctx, otherMachine)).To(Succeed())
+		DeferCleanup(k8sClient.Delete, otherMachine)
+
+		decoder = serializer.NewCodecFactory(k8sClient.Scheme(), serializer.EnableStrict).UniversalDecoder()
+
+		By("creating the worker with a provider status")
+		Expect(k8sClient.Create(ctx, w)).To(Succeed())
+		w.Status.ProviderStatus = &runtime.RawExtension{Raw: encodeObject(&apiv1alpha1.WorkerStatus{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+				Kind:       "WorkerStatus",
+			},
+			MachineImages: []apiv1alpha1.MachineImage{{Name: "my-os", Version: "0.9", Image: "registry/my-os:0.9"}},
+		})}
+		Expect(k8sClient.Status().Update(ctx, w)).To(Succeed())
+	})
+
+	decodeWorkerStatus := func(raw *runtime.RawExtension) *apiv1alpha1.WorkerStatus {
+		workerStatus := &apiv1alpha1.WorkerStatus{}
+		Expect(raw).NotTo(BeNil())
+		_, _, err := decoder.Decode(raw.Raw, nil, workerStatus)
+		Expect(err).NotTo(HaveOccurred())
+		return workerStatus
+	}
+
+	persistMachineState := func(ctx context.Context) {
+		delegate, err := NewWorkerDelegate(k8sClient, decoder, k8sClient.Scheme(), &events.FakeRecorder{}, "", w, testCluster)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(delegate.(machineStateDelegate).PersistMachineState(ctx)).To(Succeed())
+	}
+
+	// migrateWorker simulates the restoration of the worker on the destination seed, which only carries over the
+	// resources of the Worker and the objects they reference.
+	migrateWorker := func(ctx context.Context) {
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(w), w)).To(Succeed())
+		w.Status.ProviderStatus = nil
+		Expect(k8sClient.Status().Update(ctx, w)).To(Succeed())
+	}
+
+	It("should persist the machine state in the provider status and a referenced config map", func(ctx SpecContext) {
+		persistMachineState(ctx)
+
+		expectedMachines := []apiv1alpha1.MachineState{{
+			Name:                 "machine-0",
+			UID:                  machine.UID,
+			NetworkInterfaceRefs: []commonv1alpha1.LocalUIDReference{{Name: "machine-0-primary", UID: networkInterface.UID}},
+			VolumeRefs:           []commonv1alpha1.LocalUIDReference{{Name: "machine-0-root", UID: volume.UID}},
+		}}
+
+		Eventually(Object(w)).Should(HaveField("Status.Resources", ConsistOf(gardencorev1beta1.NamedResourceReference{
+			Name: "machine-state",
+			ResourceRef: autoscalingv1.CrossVersionObjectReference{
+				APIVersion: "v1",
+				Kind:       "ConfigMap",
+				Name:       w.Name + "-machine-state",
+			},
+		})))
+		workerStatus := decodeWorkerStatus(w.Status.ProviderStatus)
+		Expect(workerStatus.Machines).To(Equal(expectedMachines))
+		Expect(workerStatus.MachineImages).To(HaveLen(1))
+
+		configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: ns.Name, Name: w.Name + "-machine-state"}}
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(Succeed())
+		Expect(decodeWorkerStatus(&runtime.RawExtension{Raw: []byte(configMap.Data["workerStatus"])})).To(Equal(workerStatus))
+	})
+
+	It("should restore the provider status if the ironcore resources are unchanged", func(ctx SpecContext) {
+		persistMachineState(ctx)
+		migrateWorker(ctx)
+
+		delegate, err := NewWorkerDelegate(k8sClient, decoder, k8sClient.Scheme(), &events.FakeRecorder{}, "", w, testCluster)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(delegate.(machineStateDelegate).RestoreMachineState(ctx)).To(Succeed())
+
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(w), w)).To(Succeed())
+		workerStatus := decodeWorkerStatus(w.Status.ProviderStatus)
+		Expect(workerStatus.MachineImages).To(ConsistOf(HaveField("Image", "registry/my-os:0.9")))
+		Expect(workerStatus.Machines).To(ConsistOf(HaveField("UID", machine.UID)))
+
+		By("cleaning up the machine state once the worker is restored")
+		Expect(delegate.(machineStateDelegate).CleanupMachineState(ctx)).To(Succeed())
+
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(w), w)).To(Succeed())
+		Expect(w.Status.Resources).To(BeEmpty())
+		workerStatus = decodeWorkerStatus(w.Status.ProviderStatus)
+		Expect(workerStatus.Machines).To(BeEmpty())
+		Expect(workerStatus.MachineImages).To(HaveLen(1))
+		Expect(k8sClient.Get(ctx, client.ObjectKey{Namespace: ns.Name, Name: w.Name + "-machine-state"}, &corev1.ConfigMap{})).
+			To(Satisfy(apierrors.IsNotFound))
+	})
+
+	It("should tolerate machines which are gone on restore", func(ctx SpecContext) {
+		persistMachineState(ctx)
+		migrateWorker(ctx)
+		Expect(k8sClient.Delete(ctx, machine)).To(Succeed())
+
+		delegate, err := NewWorkerDelegate(k8sClient, decoder, k8sClient.Scheme(), &events.FakeRecorder{}, "", w, testCluster)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(delegate.(machineStateDelegate).RestoreMachineState(ctx)).To(Succeed())
+	})
+
+	It("should refuse to adopt ironcore resources which were replaced", func(ctx SpecContext) {
+		persistMachineState(ctx)
+		migrateWorker(ctx)
+
+		By("replacing the network interface of the machine")
+		Expect(k8sClient.Delete(ctx, networkInterface)).To(Succeed())
+		Expect(k8sClient.Create(ctx, &networkingv1alpha1.NetworkInterface{
+			ObjectMeta: metav1.ObjectMeta{Namespace: ns.Name, Name: networkInterface.Name},
+			Spec:       networkInterface.Spec,
+		})).To(Succeed())
+
+		delegate, err := NewWorkerDelegate(k8sClient, decoder, k8sClient.Scheme(), &events.FakeRecorder{}, "", w, testCluster)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(delegate.(machineStateDelegate).RestoreMachineState(ctx)).To(MatchError(
+			"ironcore resources of the worker have been replaced since it was migrated, refusing to adopt them: network interface machine-0-primary"))
+	})
+
+	It("should not restore anything if the worker has no machine state", func(ctx SpecContext) {
+		delegate, err := NewWorkerDelegate(k8sClient, decoder, k8sClient.Scheme(), &events.FakeRecorder{}, "", w, testCluster)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(delegate.(machineStateDelegate).RestoreMachineState(ctx)).To(Succeed())
+		Expect(delegate.(machineStateDelegate).CleanupMachineState(ctx)).To(Succeed())
+
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(w), w)).To(Succeed())
+		Expect(w.Status.Resources).To(BeEmpty())
+		Expect(decodeWorkerStatus(w.Status.ProviderStatus).MachineImages).To(HaveLen(1))
+	})
+})
@@ -7,6 +7,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"maps"
+	"slices"
 	"strconv"
 
 	"github.com/gardener/gardener/extensions/pkg/controller/worker"
@@ -23,6 +25,15 @@ import (
 
 	ironcoreextensionv1alpha1 "github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/apis/ironcore/v1alpha1"
 	"github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/ironcore"
+	"github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/ironcore/helper"
+)
+
+const (
+	// defaultNodeCIDRMaskSize is the size of the pod CIDR of a node if the shoot does not configure it.
+	defaultNodeCIDRMaskSize int32 = 24
+	// defaultMachineDeploymentPriority is the priority of a MachineDeployment if neither the worker pool nor its
+	// WorkerConfig configure one.
+	defaultMachineDeploymentPriority int32 = 1
 )
 
 // DeployMachineClasses generates and creates the ironcore specific machine classes.
@@ -34,9 +45,11 @@ func (w *workerDelegate) DeployMachineClasses(ctx context.Context) error {
 
 	// apply machine classes and machine secrets
 	for _, class := range machineClasses {
-		if _, err := controllerutil.CreateOrPatch(ctx, w.client, class, nil); err != nil {
+		result, err := controllerutil.CreateOrPatch(ctx, w.client, class, nil)
+		if err != nil {
 			return fmt.Errorf("failed to create/patch machineclass %s: %w", client.ObjectKeyFromObject(class), err)
 		}
+		ironcore.RecordApplyEvent(w.recorder, w.worker, class, result)
 	}
 	for _, secret := range machineClassSecrets {
 		if _, err := controllerutil.CreateOrPatch(ctx, w.client, secret, nil); err != nil {
@@ -54,8 +67,14 @@ func (w *workerDelegate) GenerateMachineDeployments(ctx context.Context) (worker
 	)
 
 	for _, pool := range w.worker.Spec.Pools {
+		// Priorities are not part of the worker pool hash, the cluster-autoscaler picks up changes without new machines.
+		zonePriorities, err := w.getZonePriorities(pool)
+		if err != nil {
+			return nil, fmt.Errorf("failed to determine priorities of worker pool %s: %w", pool.Name, err)
+		}
+
 		zoneLen := int32(len(pool.Zones))
-		for zoneIndex := range pool.Zones {
+		for zoneIndex, zone := range pool.Zones {
 			workerPoolHash, err := w.generateHashForWorkerPool(pool)
 			if err != nil {
 				return nil, err
@@ -89,7 +108,7 @@ func (w *workerDelegate) GenerateMachineDeployments(ctx context.Context) (worker
 				Annotations:          pool.Annotations,
 				Taints:               pool.Taints,
 				MachineConfiguration: genericworkeractuator.ReadMachineConfiguration(pool),
-				Priority:             ptr.To(int32(1)),
+				Priority:             ptr.To(zonePriorities[zone]),
 			})
 		}
 	}
@@ -108,12 +127,24 @@ func (w *workerDelegate) generateMachineClassAndSecrets(ctx context.Context) ([]
 		return nil, nil, fmt.Errorf("failed to decode infra status: %w", err)
 	}
 
+	podPrefix, err := w.getPodPrefixConfig()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	machinePoolSelectors := w.getMachinePoolSelectors()
+
 	for _, pool := range w.worker.Spec.Pools {
 		workerPoolHash, err := w.generateHashForWorkerPool(pool)
 		if err != nil {
 			return nil, nil, fmt.Errorf("failed to generate hash for worker pool %s: %w", pool.Name, err)
 		}
 
+		workerConfig, err := w.decodeWorkerConfig(pool)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to decode worker config of worker pool %s: %w", pool.Name, err)
+		}
+
 		arch := ptr.Deref[string](pool.Architecture, v1beta1constants.ArchitectureAMD64)
 		machineImage, err := w.findMachineImage(pool.MachineImage.Name, pool.MachineImage.Version, &arch)
 		if err != nil {
@@ -124,6 +155,13 @@ func (w *workerDelegate) generateMachineClassAndSecrets(ctx context.Context) ([]
 			ironcore.ImageFieldName: machineImage,
 		}
 
+		if podPrefix != nil {
+			machineClassProviderSpec[ironcore.PodPrefixFieldName] = map[string]interface{}{
+				ironcore.PodPrefixModeFieldName: string(podPrefix.mode),
+				ironcore.PrefixLengthFieldName:  podPrefix.prefixLength,
+			}
+		}
+
 		if pool.Volume != nil {
 			machineClassProviderSpec[ironcore.RootDiskFieldName] = map[string]interface{}{
 				ironcore.SizeFieldName:        pool.Volume.Size,
@@ -131,6 +169,24 @@ func (w *workerDelegate) generateMachineClassAndSecrets(ctx context.Context) ([]
 			}
 		}
 
+		if len(workerConfig.NetworkInterfaces) > 0 {
+			networkInterfaces := make([]map[string]interface{}, 0, len(workerConfig.NetworkInterfaces))
+			for _, networkInterface := range workerConfig.NetworkInterfaces {
+				networkInterfaceSpec := map[string]interface{}{
+					ironcore.NameFieldName:    networkInterface.Name,
+					ironcore.NetworkFieldName: networkInterface.NetworkName,
+				}
+				if len(networkInterface.Prefixes) > 0 {
+					networkInterfaceSpec[ironcore.PrefixesFieldName] = networkInterface.Prefixes
+				}
+				if len(networkInterface.Labels) > 0 {
+					networkInterfaceSpec[ironcore.LabelsFieldName] = networkInterface.Labels
+				}
+				networkInterfaces = append(networkInterfaces, networkInterfaceSpec)
+			}
+			machineClassProviderSpec[ironcore.NetworkInterfacesFieldName] = networkInterfaces
+		}
+
 		for zoneIndex, zone := range pool.Zones {
 			var (
 				deploymentName = fmt.Sprintf("%s-%s-z%d", w.worker.Namespace, pool.Name, zoneIndex+1)
@@ -141,14 +197,12 @@ func (w *workerDelegate) generateMachineClassAndSecrets(ctx context.Context) ([]
 			// 1. construct a MachineClass per zone containing the ProviderSpec needed by the MCM
 			// 2. construct a Secret for each MachineClass containing the user-data
 
-			nodeTemplate := &machinecontrollerv1alpha1.NodeTemplate{}
-			if pool.NodeTemplate != nil {
-				nodeTemplate = &machinecontrollerv1alpha1.NodeTemplate{
-					Capacity:     pool.NodeTemplate.Capacity,
-					InstanceType: pool.MachineType,
-					Region:       w.worker.Spec.Region,
-					Zone:         zone,
-				}
+			nodeTemplate := w.generateNodeTemplate(ctx, pool, zone)
+
+			if selector, ok := machinePoolSelectors[zone]; ok {
+				machineClassProviderSpec[ironcore.MachinePoolSelectorFieldName] = selector
+			} else {
+				delete(machineClassProviderSpec, ironcore.MachinePoolSelectorFieldName)
 			}
 
 			machineClassProviderSpec[ironcore.NetworkFieldName] = infrastructureStatus.NetworkRef.Name
@@ -206,9 +260,135 @@ func (w *workerDelegate) generateMachineClassAndSecrets(ctx context.Context) ([]
 	return machineClasses, machineClassSecrets, nil
 }
 
+// getMachinePoolSelectors returns the MachinePool selectors of the zones of the worker's region, keyed by zone name.
+// Zones without a selector are omitted, their machines are placed by the ironcore scheduler.
+func (w *workerDelegate) getMachinePoolSelectors() map[string]map[string]string {
+	selectors := map[string]map[string]string{}
+	if w.cloudProfileConfig == nil {
+		return selectors
+	}
+	for _, regionConfig := range w.cloudProfileConfig.RegionConfigs {
+		if regionConfig.Name != w.worker.Spec.Region {
+			continue
+		}
+		for _, zone := range regionConfig.Zones {
+			if len(zone.MachinePoolSelector) > 0 {
+				selectors[zone.Name] = zone.MachinePoolSelector
+			}
+		}
+	}
+	return selectors
+}
+
+// decodeWorkerConfig returns the WorkerConfig of the given pool, or an empty one if the pool has no provider config.
+func (w *workerDelegate) decodeWorkerConfig(pool v1alpha1.WorkerPool) (*ironcoreextensionv1alpha1.WorkerConfig, error) {
+	workerConfig := &ironcoreextensionv1alpha1.WorkerConfig{}
+	if pool.ProviderConfig != nil && pool.ProviderConfig.Raw != nil {
+		if _, _, err := w.decoder.Decode(pool.ProviderConfig.Raw, nil, workerConfig); err != nil {
+			return nil, err
+		}
+	}
+	return workerConfig, nil
+}
+
+// getZonePriorities returns the priority of the MachineDeployment of every zone of the given pool. The priority of a
+// zone in the WorkerConfig of the pool takes precedence over the priority of the WorkerConfig, which in turn takes
+// precedence over the priority of the pool in the shoot.
+func (w *workerDelegate) getZonePriorities(pool v1alpha1.WorkerPool) (map[string]int32, error) {
+	workerConfig, err := w.decodeWorkerConfig(pool)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode worker config: %w", err)
+	}
+
+	priority := ptr.Deref(pool.Priority, defaultMachineDeploymentPriority)
+	if workerConfig.Priority != nil {
+		priority = *workerConfig.Priority
+	}
+
+	priorities := make(map[string]int32, len(pool.Zones))
+	for _, zone := range pool.Zones {
+		priorities[zone] = priority
+	}
+	for _, zonePriority := range workerConfig.ZonePriorities {
+		if _, ok := priorities[zonePriority.Zone]; ok {
+			priorities[zonePriority.Zone] = zonePriority.Priority
+		}
+	}
+	return priorities, nil
+}
+
 func (w *workerDelegate) generateHashForWorkerPool(pool v1alpha1.WorkerPool) (string, error) {
+	additionalData := computeAdditionalHashDataV1(pool)
+
+	podPrefix, err := w.getPodPrefixConfig()
+	if err != nil {
+		return "", err
+	}
+	if podPrefix != nil && !podPrefix.isDefault() {
+		// Changing how the pod CIDR is attached to the NetworkInterface requires new machines. The default
+		// configuration is left out of the hash to keep the machines of existing worker pools.
+		additionalData = append(additionalData, string(podPrefix.mode), strconv.Itoa(int(podPrefix.prefixLength)))
+	}
+
+	workerConfig, err := w.decodeWorkerConfig(pool)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode worker config: %w", err)
+	}
+	// Network interfaces are only attached when a machine is created.
+	for _, networkInterface := range workerConfig.NetworkInterfaces {
+		additionalData = append(additionalData, networkInterface.Name, networkInterface.NetworkName)
+		additionalData = append(additionalData, slices.Sorted(slices.Values(networkInterface.Prefixes))...)
+		for _, key := range slices.Sorted(maps.Keys(networkInterface.Labels)) {
+			additionalData = append(additionalData, key, networkInterface.Labels[key])
+		}
+	}
+
 	// Generate the worker pool hash.
-	return worker.WorkerPoolHash(pool, w.cluster, computeAdditionalHashDataV1(pool), nil)
+	return worker.WorkerPoolHash(pool, w.cluster, additionalData, nil)
+}
+
+// podPrefixConfig describes the per-node pod prefix which is attached to the NetworkInterface of a machine.
+type podPrefixConfig struct {
+	mode         ironcoreextensionv1alpha1.PodPrefixMode
+	prefixLength int32
+}
+
+// isDefault returns true if the pod prefix configuration equals the one of a shoot which does not configure it.
+func (c *podPrefixConfig) isDefault() bool {
+	return c.mode == ironcoreextensionv1alpha1.PodPrefixModeRouted && c.prefixLength == defaultNodeCIDRMaskSize
+}
+
+// getPodPrefixConfig returns the pod prefix configuration for the machines of the shoot. It returns nil if the
+// shoot networking uses an overlay, since pod traffic is encapsulated then and no pod prefix is needed.
+func (w *workerDelegate) getPodPrefixConfig() (*podPrefixConfig, error) {
+	overlayEnabled, err := helper.IsOverlayEnabled(w.cluster.Shoot.Spec.Networking)
+	if err != nil {
+		return nil, fmt.Errorf("failed to determine if overlay is enabled: %w", err)
+	}
+	if overlayEnabled {
+		return nil, nil
+	}
+
+	config := &podPrefixConfig{
+		mode:         ironcoreextensionv1alpha1.PodPrefixModeRouted,
+		prefixLength: defaultNodeCIDRMaskSize,
+	}
+
+	if raw := w.cluster.Shoot.Spec.Provider.ControlPlaneConfig; raw != nil && raw.Raw != nil {
+		cpConfig := &ironcoreextensionv1alpha1.ControlPlaneConfig{}
+		if _, _, err := w.decoder.Decode(raw.Raw, nil, cpConfig); err != nil {
+			return nil, fmt.Errorf("failed to decode control plane config: %w", err)
+		}
+		if ccm := cpConfig.CloudControllerManager; ccm != nil && ccm.RouteController != nil && ccm.RouteController.PodPrefixMode != nil {
+			config.mode = *ccm.RouteController.PodPrefixMode
+		}
+	}
+
+	if kcm := w.cluster.Shoot.Spec.Kubernetes.KubeControllerManager; kcm != nil && kcm.NodeCIDRMaskSize != nil {
+		config.prefixLength = *kcm.NodeCIDRMaskSize
+	}
+
+	return config, nil
 }
 
 func computeAdditionalHashDataV1(pool v1alpha1.WorkerPool) []string {
@@ -10,16 +10,23 @@ import (
 
 	"github.com/gardener/gardener/extensions/pkg/controller/worker"
 	genericworkeractuator "github.com/gardener/gardener/extensions/pkg/controller/worker/genericactuator"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	gardenerextensionv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	machinecontrollerv1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
 	commonv1alpha1 "github.com/ironcore-dev/ironcore/api/common/v1alpha1"
+	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	corev1alpha1 "github.com/ironcore-dev/ironcore/api/core/v1alpha1"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/client-go/tools/events"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	. "sigs.k8s.io/controller-runtime/pkg/envtest/komega"
 
 	ironcoreextensionv1alpha1 "github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/apis/ironcore/v1alpha1"
@@ -49,7 +56,7 @@ var _ = Describe("Machines", func() {
 
 		By("deploying the machine class for a given multi zone cluster")
 		decoder := serializer.NewCodecFactory(k8sClient.Scheme(), serializer.EnableStrict).UniversalDecoder()
-		workerDelegate, err := NewWorkerDelegate(k8sClient, decoder, k8sClient.Scheme(), "", w, testCluster)
+		workerDelegate, err := NewWorkerDelegate(k8sClient, decoder, k8sClient.Scheme(), &events.FakeRecorder{}, "", w, testCluster)
 		Expect(err).NotTo(HaveOccurred())
 
 		err = workerDelegate.DeployMachineClasses(ctx)
@@ -120,6 +127,379 @@ var _ = Describe("Machines", func() {
 		))
 	})
 
+	It("should attach a pod prefix to the machine class if the overlay is disabled", func(ctx SpecContext) {
+		By("disabling the overlay and configuring alias pod prefixes for the shoot")
+		testCluster.Shoot.Spec.Networking = &gardencorev1beta1.Networking{
+			ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"overlay":{"enabled":false}}`)},
+		}
+		testCluster.Shoot.Spec.Kubernetes.KubeControllerManager = &gardencorev1beta1.KubeControllerManagerConfig{
+			NodeCIDRMaskSize: ptr.To[int32](26),
+		}
+		testCluster.Shoot.Spec.Provider.ControlPlaneConfig = &runtime.RawExtension{Raw: encodeObject(&ironcoreextensionv1alpha1.ControlPlaneConfig{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: ironcoreextensionv1alpha1.SchemeGroupVersion.String(),
+				Kind:       "ControlPlaneConfig",
+			},
+			CloudControllerManager: &ironcoreextensionv1alpha1.CloudControllerManagerConfig{
+				RouteController: &ironcoreextensionv1alpha1.RouteControllerConfig{
+					PodPrefixMode: ptr.To(ironcoreextensionv1alpha1.PodPrefixModeAlias),
+				},
+			},
+		})}
+
+		infraStatus := &ironcoreextensionv1alpha1.InfrastructureStatus{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: ironcoreextensionv1alpha1.SchemeGroupVersion.String(),
+				Kind:       "InfrastructureStatus",
+			},
+			NetworkRef: commonv1alpha1.LocalUIDReference{Name: "my-network", UID: "1234"},
+			PrefixRef:  commonv1alpha1.LocalUIDReference{Name: "my-prefix", UID: "3766"},
+		}
+		w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{Raw: encodeObject(infraStatus)}
+
+		By("deploying the machine classes")
+		decoder := serializer.NewCodecFactory(k8sClient.Scheme(), serializer.EnableStrict).UniversalDecoder()
+		workerDelegate, err := NewWorkerDelegate(k8sClient, decoder, k8sClient.Scheme(), &events.FakeRecorder{}, "", w, testCluster)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(workerDelegate.DeployMachineClasses(ctx)).To(Succeed())
+
+		additionalData := []string{strconv.FormatBool(volumeEncrypted), datVolumeName, volumeSize, volumeType, strconv.FormatBool(volumeEncrypted), "Alias", "26"}
+		workerPoolHash, err := worker.WorkerPoolHash(pool, testCluster, additionalData, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		By("ensuring that the machine class contains the pod prefix")
+		machineClass := &machinecontrollerv1alpha1.MachineClass{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns.Name,
+				Name:      fmt.Sprintf("%s-%s-z%d-%s", ns.Name, pool.Name, 1, workerPoolHash),
+			},
+		}
+		Eventually(Object(machineClass)).Should(HaveField("ProviderSpec", runtime.RawExtension{
+			Raw: encodeMap(map[string]interface{}{
+				"image": "registry/my-os",
+				"rootDisk": map[string]interface{}{
+					"size":            pool.Volume.Size,
+					"volumeClassName": pool.Volume.Type,
+				},
+				"podPrefix": map[string]interface{}{
+					"mode":         "Alias",
+					"prefixLength": 26,
+				},
+				"networkName": infraStatus.NetworkRef.Name,
+				"prefixName":  infraStatus.PrefixRef.Name,
+				"labels": map[string]interface{}{
+					ironcore.ClusterNameLabel: testCluster.ObjectMeta.Name,
+				},
+			}),
+		}))
+	})
+
+	It("should keep the worker pool hash for the default pod prefix configuration", func(ctx SpecContext) {
+		By("disabling the overlay for the shoot")
+		testCluster.Shoot.Spec.Networking = &gardencorev1beta1.Networking{
+			ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"overlay":{"enabled":false}}`)},
+		}
+
+		infraStatus := &ironcoreextensionv1alpha1.InfrastructureStatus{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: ironcoreextensionv1alpha1.SchemeGroupVersion.String(),
+				Kind:       "InfrastructureStatus",
+			},
+			NetworkRef: commonv1alpha1.LocalUIDReference{Name: "my-network", UID: "1234"},
+			PrefixRef:  commonv1alpha1.LocalUIDReference{Name: "my-prefix", UID: "3766"},
+		}
+		w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{Raw: encodeObject(infraStatus)}
+
+		By("deploying the machine classes")
+		decoder := serializer.NewCodecFactory(k8sClient.Scheme(), serializer.EnableStrict).UniversalDecoder()
+		workerDelegate, err := NewWorkerDelegate(k8sClient, decoder, k8sClient.Scheme(), &events.FakeRecorder{}, "", w, testCluster)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(workerDelegate.DeployMachineClasses(ctx)).To(Succeed())
+
+		By("ensuring that the machine class keeps the name of an existing worker pool")
+		additionalData := []string{strconv.FormatBool(volumeEncrypted), datVolumeName, volumeSize, volumeType, strconv.FormatBool(volumeEncrypted)}
+		workerPoolHash, err := worker.WorkerPoolHash(pool, testCluster, additionalData, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		machineClass := &machinecontrollerv1alpha1.MachineClass{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns.Name,
+				Name:      fmt.Sprintf("%s-%s-z%d-%s", ns.Name, pool.Name, 1, workerPoolHash),
+			},
+		}
+		Eventually(Object(machineClass)).Should(HaveField("ProviderSpec", runtime.RawExtension{
+			Raw: encodeMap(map[string]interface{}{
+				"image": "registry/my-os",
+				"rootDisk": map[string]interface{}{
+					"size":            pool.Volume.Size,
+					"volumeClassName": pool.Volume.Type,
+				},
+				"podPrefix": map[string]interface{}{
+					"mode":         "Routed",
+					"prefixLength": 24,
+				},
+				"networkName": infraStatus.NetworkRef.Name,
+				"prefixName":  infraStatus.PrefixRef.Name,
+				"labels": map[string]interface{}{
+					ironcore.ClusterNameLabel: testCluster.ObjectMeta.Name,
+				},
+			}),
+		}))
+	})
+
+	It("should pin the machines of a zone to the MachinePools of its zone config", func(ctx SpecContext) {
+		By("configuring a machine pool selector for the first zone")
+		cloudProfileConfig.RegionConfigs = []ironcoreextensionv1alpha1.RegionConfig{
+			{
+				Name:   "foo",
+				Server: "https://foo.example.com",
+				Zones: []ironcoreextensionv1alpha1.ZoneConfig{
+					{Name: "zone1", MachinePoolSelector: map[string]string{"topology.ironcore.dev/zone": "zone1"}},
+					{Name: "zone2"},
+				},
+			},
+		}
+		testCluster.CloudProfile.Spec.ProviderConfig = &runtime.RawExtension{Raw: encodeObject(cloudProfileConfig)}
+
+		infraStatus := &ironcoreextensionv1alpha1.InfrastructureStatus{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: ironcoreextensionv1alpha1.SchemeGroupVersion.String(),
+				Kind:       "InfrastructureStatus",
+			},
+			NetworkRef: commonv1alpha1.LocalUIDReference{Name: "my-network", UID: "1234"},
+			PrefixRef:  commonv1alpha1.LocalUIDReference{Name: "my-prefix", UID: "3766"},
+		}
+		w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{Raw: encodeObject(infraStatus)}
+
+		By("deploying the machine classes")
+		decoder := serializer.NewCodecFactory(k8sClient.Scheme(), serializer.EnableStrict).UniversalDecoder()
+		workerDelegate, err := NewWorkerDelegate(k8sClient, decoder, k8sClient.Scheme(), &events.FakeRecorder{}, "", w, testCluster)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(workerDelegate.DeployMachineClasses(ctx)).To(Succeed())
+
+		additionalData := []string{strconv.FormatBool(volumeEncrypted), datVolumeName, volumeSize, volumeType, strconv.FormatBool(volumeEncrypted)}
+		workerPoolHash, err := worker.WorkerPoolHash(pool, testCluster, additionalData, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		providerSpec := func(machinePoolSelector map[string]interface{}) map[string]interface{} {
+			spec := map[string]interface{}{
+				"image": "registry/my-os",
+				"rootDisk": map[string]interface{}{
+					"size":            pool.Volume.Size,
+					"volumeClassName": pool.Volume.Type,
+				},
+				"networkName": infraStatus.NetworkRef.Name,
+				"prefixName":  infraStatus.PrefixRef.Name,
+				"labels": map[string]interface{}{
+					ironcore.ClusterNameLabel: testCluster.ObjectMeta.Name,
+				},
+			}
+			if machinePoolSelector != nil {
+				spec["machinePoolSelector"] = machinePoolSelector
+			}
+			return spec
+		}
+
+		By("ensuring that only the machine class of the first zone selects machine pools")
+		machineClass1 := &machinecontrollerv1alpha1.MachineClass{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns.Name,
+				Name:      fmt.Sprintf("%s-%s-z%d-%s", ns.Name, pool.Name, 1, workerPoolHash),
+			},
+		}
+		Eventually(Object(machineClass1)).Should(HaveField("ProviderSpec", runtime.RawExtension{
+			Raw: encodeMap(providerSpec(map[string]interface{}{"topology.ironcore.dev/zone": "zone1"})),
+		}))
+
+		machineClass2 := &machinecontrollerv1alpha1.MachineClass{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns.Name,
+				Name:      fmt.Sprintf("%s-%s-z%d-%s", ns.Name, pool.Name, 2, workerPoolHash),
+			},
+		}
+		Eventually(Object(machineClass2)).Should(HaveField("ProviderSpec", runtime.RawExtension{
+			Raw: encodeMap(providerSpec(nil)),
+		}))
+	})
+
+	It("should derive the node template from the ironcore machine class", func(ctx SpecContext) {
+		By("providing an ironcore machine class for the machine type of the pool")
+		ironcoreMachineClass := &computev1alpha1.MachineClass{
+			ObjectMeta: metav1.ObjectMeta{Name: pool.MachineType},
+			Capabilities: corev1alpha1.ResourceList{
+				corev1alpha1.ResourceCPU:    resource.MustParse("4"),
+				corev1alpha1.ResourceMemory: resource.MustParse("16Gi"),
+			},
+		}
+		Expect(k8sClient.Create(ctx, ironcoreMachineClass)).To(Succeed())
+		DeferCleanup(client.IgnoreNotFound, k8sClient.Delete, ironcoreMachineClass)
+
+		pool.NodeTemplate = nil
+		w.Spec.Pools = []gardenerextensionv1alpha1.WorkerPool{pool}
+		infraStatus := &ironcoreextensionv1alpha1.InfrastructureStatus{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: ironcoreextensionv1alpha1.SchemeGroupVersion.String(),
+				Kind:       "InfrastructureStatus",
+			},
+			NetworkRef: commonv1alpha1.LocalUIDReference{Name: "my-network", UID: "1234"},
+			PrefixRef:  commonv1alpha1.LocalUIDReference{Name: "my-prefix", UID: "3766"},
+		}
+		w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{Raw: encodeObject(infraStatus)}
+
+		By("deploying the machine classes")
+		decoder := serializer.NewCodecFactory(k8sClient.Scheme(), serializer.EnableStrict).UniversalDecoder()
+		delegate, err := NewWorkerDelegate(k8sClient, decoder, k8sClient.Scheme(), &events.FakeRecorder{}, "", w, testCluster)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(delegate.DeployMachineClasses(ctx)).To(Succeed())
+
+		additionalData := []string{strconv.FormatBool(volumeEncrypted), datVolumeName, volumeSize, volumeType, strconv.FormatBool(volumeEncrypted)}
+		workerPoolHash, err := worker.WorkerPoolHash(pool, testCluster, additionalData, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		By("ensuring that the machine classes of both zones carry the capacity of the ironcore machine class")
+		for zoneIndex, zone := range pool.Zones {
+			machineClass := &machinecontrollerv1alpha1.MachineClass{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: ns.Name,
+					Name:      fmt.Sprintf("%s-%s-z%d-%s", ns.Name, pool.Name, zoneIndex+1, workerPoolHash),
+				},
+			}
+			Eventually(Object(machineClass)).Should(HaveField("NodeTemplate", &machinecontrollerv1alpha1.NodeTemplate{
+				Capacity: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("4"),
+					corev1.ResourceMemory: resource.MustParse("16Gi"),
+				},
+				InstanceType: pool.MachineType,
+				Region:       w.Spec.Region,
+				Zone:         zone,
+			}))
+		}
+
+		By("ensuring that the ironcore machine class is only read once per delegate")
+		Expect(k8sClient.Delete(ctx, ironcoreMachineClass)).To(Succeed())
+		Expect(delegate.(*workerDelegate).generateNodeTemplate(ctx, pool, "zone1")).To(HaveField("Capacity", corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("4"),
+			corev1.ResourceMemory: resource.MustParse("16Gi"),
+		}))
+	})
+
+	It("should fall back to an empty node template if the ironcore machine class is missing", func(ctx SpecContext) {
+		pool.NodeTemplate = nil
+		pool.MachineType = "missing"
+		w.Spec.Pools = []gardenerextensionv1alpha1.WorkerPool{pool}
+		infraStatus := &ironcoreextensionv1alpha1.InfrastructureStatus{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: ironcoreextensionv1alpha1.SchemeGroupVersion.String(),
+				Kind:       "InfrastructureStatus",
+			},
+			NetworkRef: commonv1alpha1.LocalUIDReference{Name: "my-network", UID: "1234"},
+			PrefixRef:  commonv1alpha1.LocalUIDReference{Name: "my-prefix", UID: "3766"},
+		}
+		w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{Raw: encodeObject(infraStatus)}
+
+		By("deploying the machine classes")
+		recorder := events.NewFakeRecorder(10)
+		decoder := serializer.NewCodecFactory(k8sClient.Scheme(), serializer.EnableStrict).UniversalDecoder()
+		workerDelegate, err := NewWorkerDelegate(k8sClient, decoder, k8sClient.Scheme(), recorder, "", w, testCluster)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(workerDelegate.DeployMachineClasses(ctx)).To(Succeed())
+		Expect(recorder.Events).To(Receive(HavePrefix("Warning MachineClassUnavailable Falling back to defaults for ironcore MachineClass missing")))
+		Expect(recorder.Events).NotTo(Receive())
+
+		additionalData := []string{strconv.FormatBool(volumeEncrypted), datVolumeName, volumeSize, volumeType, strconv.FormatBool(volumeEncrypted)}
+		workerPoolHash, err := worker.WorkerPoolHash(pool, testCluster, additionalData, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		By("ensuring that the machine classes carry an empty node template")
+		for zoneIndex := range pool.Zones {
+			machineClass := &machinecontrollerv1alpha1.MachineClass{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: ns.Name,
+					Name:      fmt.Sprintf("%s-%s-z%d-%s", ns.Name, pool.Name, zoneIndex+1, workerPoolHash),
+				},
+			}
+			Eventually(Object(machineClass)).Should(HaveField("NodeTemplate", &machinecontrollerv1alpha1.NodeTemplate{}))
+		}
+	})
+
+	It("should attach the additional network interfaces of the worker config to the machine class", func(ctx SpecContext) {
+		networkInterfaces := []ironcoreextensionv1alpha1.NetworkInterfaceConfig{{
+			Name:        "storage",
+			NetworkName: "storage-network",
+			Prefixes:    []string{"fd00::/64", "10.10.0.0/24"},
+			Labels:      map[string]string{"network.example.com/role": "storage"},
+		}}
+		w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{Raw: encodeObject(&ironcoreextensionv1alpha1.WorkerConfig{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: ironcoreextensionv1alpha1.SchemeGroupVersion.String(),
+				Kind:       "WorkerConfig",
+			},
+			NetworkInterfaces: networkInterfaces,
+		})}
+		infraStatus := &ironcoreextensionv1alpha1.InfrastructureStatus{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: ironcoreextensionv1alpha1.SchemeGroupVersion.String(),
+				Kind:       "InfrastructureStatus",
+			},
+			NetworkRef: commonv1alpha1.LocalUIDReference{Name: "my-network", UID: "1234"},
+			PrefixRef:  commonv1alpha1.LocalUIDReference{Name: "my-prefix", UID: "3766"},
+		}
+		w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{Raw: encodeObject(infraStatus)}
+
+		By("deploying the machine classes")
+		decoder := serializer.NewCodecFactory(k8sClient.Scheme(), serializer.EnableStrict).UniversalDecoder()
+		delegate, err := NewWorkerDelegate(k8sClient, decoder, k8sClient.Scheme(), &events.FakeRecorder{}, "", w, testCluster)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(delegate.DeployMachineClasses(ctx)).To(Succeed())
+
+		By("ensuring that the network interfaces are part of the worker pool hash and the provider spec")
+		additionalData := []string{strconv.FormatBool(volumeEncrypted), datVolumeName, volumeSize, volumeType, strconv.FormatBool(volumeEncrypted),
+			"storage", "storage-network", "10.10.0.0/24", "fd00::/64", "network.example.com/role", "storage"}
+		workerPoolHash, err := worker.WorkerPoolHash(w.Spec.Pools[0], testCluster, additionalData, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		By("ensuring that the order of the prefixes does not change the worker pool hash")
+		reorderedPool := *w.Spec.Pools[0].DeepCopy()
+		networkInterfaces[0].Prefixes = []string{"10.10.0.0/24", "fd00::/64"}
+		reorderedPool.ProviderConfig = &runtime.RawExtension{Raw: encodeObject(&ironcoreextensionv1alpha1.WorkerConfig{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: ironcoreextensionv1alpha1.SchemeGroupVersion.String(),
+				Kind:       "WorkerConfig",
+			},
+			NetworkInterfaces: networkInterfaces,
+		})}
+		Expect(delegate.(*workerDelegate).generateHashForWorkerPool(reorderedPool)).To(Equal(workerPoolHash))
+
+		machineClass := &machinecontrollerv1alpha1.MachineClass{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns.Name,
+				Name:      fmt.Sprintf("%s-%s-z%d-%s", ns.Name, pool.Name, 1, workerPoolHash),
+			},
+		}
+		Eventually(Object(machineClass)).Should(HaveField("ProviderSpec", runtime.RawExtension{
+			Raw: encodeMap(map[string]interface{}{
+				"image": "registry/my-os",
+				"rootDisk": map[string]interface{}{
+					"size":            pool.Volume.Size,
+					"volumeClassName": pool.Volume.Type,
+				},
+				"networkName": infraStatus.NetworkRef.Name,
+				"prefixName":  infraStatus.PrefixRef.Name,
+				"labels": map[string]interface{}{
+					ironcore.ClusterNameLabel: testCluster.ObjectMeta.Name,
+				},
+				"networkInterfaces": []interface{}{
+					map[string]interface{}{
+						"name":        "storage",
+						"networkName": "storage-network",
+						"prefixes":    []interface{}{"fd00::/64", "10.10.0.0/24"},
+						"labels":      map[string]interface{}{"network.example.com/role": "storage"},
+					},
+				},
+			}),
+		}))
+	})
+
 	It("should generate the machine deployments", func(ctx SpecContext) {
 		By("creating a worker delegate")
 		additionalData := []string{strconv.FormatBool(volumeEncrypted), datVolumeName, volumeSize, volumeType, strconv.FormatBool(volumeEncrypted)}
@@ -132,7 +512,7 @@ var _ = Describe("Machines", func() {
 			className2      = fmt.Sprintf("%s-%s", deploymentName2, workerPoolHash)
 		)
 		decoder := serializer.NewCodecFactory(k8sClient.Scheme(), serializer.EnableStrict).UniversalDecoder()
-		workerDelegate, err := NewWorkerDelegate(k8sClient, decoder, k8sClient.Scheme(), "", w, testCluster)
+		workerDelegate, err := NewWorkerDelegate(k8sClient, decoder, k8sClient.Scheme(), &events.FakeRecorder{}, "", w, testCluster)
 		Expect(err).NotTo(HaveOccurred())
 
 		By("generating the machine deployments")
@@ -184,6 +564,48 @@ var _ = Describe("Machines", func() {
 			},
 		}))
 	})
+
+	It("should prioritize the machine deployments according to the worker config", func(ctx SpecContext) {
+		By("configuring the priorities of the worker pool")
+		w.Spec.Pools[0].Priority = ptr.To(int32(5))
+		w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{Raw: encodeObject(&ironcoreextensionv1alpha1.WorkerConfig{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: ironcoreextensionv1alpha1.SchemeGroupVersion.String(),
+				Kind:       "WorkerConfig",
+			},
+			ZonePriorities: []ironcoreextensionv1alpha1.ZonePriority{{Zone: "zone2", Priority: 20}},
+		})}
+
+		decoder := serializer.NewCodecFactory(k8sClient.Scheme(), serializer.EnableStrict).UniversalDecoder()
+		workerDelegate, err := NewWorkerDelegate(k8sClient, decoder, k8sClient.Scheme(), &events.FakeRecorder{}, "", w, testCluster)
+		Expect(err).NotTo(HaveOccurred())
+
+		By("ensuring that the zone priority takes precedence over the priority of the pool")
+		machineDeployments, err := workerDelegate.GenerateMachineDeployments(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(machineDeployments).To(HaveExactElements(
+			SatisfyAll(
+				HaveField("Name", fmt.Sprintf("%s-%s-z%d", w.Namespace, pool.Name, 1)),
+				HaveField("Priority", ptr.To(int32(5))),
+			),
+			SatisfyAll(
+				HaveField("Name", fmt.Sprintf("%s-%s-z%d", w.Namespace, pool.Name, 2)),
+				HaveField("Priority", ptr.To(int32(20))),
+			),
+		))
+
+		By("ensuring that the priority of the worker config takes precedence over the priority of the pool")
+		w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{Raw: encodeObject(&ironcoreextensionv1alpha1.WorkerConfig{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: ironcoreextensionv1alpha1.SchemeGroupVersion.String(),
+				Kind:       "WorkerConfig",
+			},
+			Priority: ptr.To(int32(7)),
+		})}
+		machineDeployments, err = workerDelegate.GenerateMachineDeployments(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(machineDeployments).To(HaveEach(HaveField("Priority", ptr.To(int32(7)))))
+	})
 })
 
 func encodeObject(obj runtime.Object) []byte {
@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"context"
+	"fmt"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	machinecontrollerv1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	corev1alpha1 "github.com/ironcore-dev/ironcore/api/core/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/ironcore"
+)
+
+// generateNodeTemplate returns the NodeTemplate of the machines of the given pool in the given zone. The
+// cluster-autoscaler uses it to scale pools from zero. The capacity of a NodeTemplate of the pool takes precedence,
+// otherwise the CPU and memory are taken from the capabilities of the ironcore MachineClass of the pool. If the
+// MachineClass cannot be read, the NodeTemplate is left empty.
+func (w *workerDelegate) generateNodeTemplate(ctx context.Context, pool extensionsv1alpha1.WorkerPool, zone string) *machinecontrollerv1alpha1.NodeTemplate {
+	var capacity corev1.ResourceList
+	if pool.NodeTemplate != nil {
+		capacity = pool.NodeTemplate.Capacity
+	} else {
+		machineClassCapacity, ok := w.getMachineClassCapacity(ctx, pool.MachineType)
+		if !ok {
+			return &machinecontrollerv1alpha1.NodeTemplate{}
+		}
+		capacity = machineClassCapacity
+	}
+
+	return &machinecontrollerv1alpha1.NodeTemplate{
+		Capacity:     capacity,
+		InstanceType: pool.MachineType,
+		Region:       w.worker.Spec.Region,
+		Zone:         zone,
+	}
+}
+
+// getMachineClassCapacity returns the CPU and memory of the ironcore MachineClass with the given name. It returns false
+// if the MachineClass cannot be read, which is recorded as a warning Event on the worker instead of failing, since the
+// machine classes are also deployed when the worker is deleted. The result is cached for the lifetime of the delegate,
+// so every MachineClass is read at most once per reconciliation.
+func (w *workerDelegate) getMachineClassCapacity(ctx context.Context, name string) (corev1.ResourceList, bool) {
+	if capacity, ok := w.machineClassCapacities[name]; ok {
+		return capacity, capacity != nil
+	}
+
+	capacity, err := w.readMachineClassCapacity(ctx, name)
+	if err != nil {
+		w.recorder.Eventf(w.worker, nil, corev1.EventTypeWarning, ironcore.EventReasonMachineClassUnavailable, "Get",
+			"Falling back to defaults for ironcore MachineClass %s: %v", name, err)
+	}
+	w.machineClassCapacities[name] = capacity
+	return capacity, capacity != nil
+}
+
+func (w *workerDelegate) readMachineClassCapacity(ctx context.Context, name string) (corev1.ResourceList, error) {
+	ironcoreClient, _, err := w.getIroncoreClientAndNamespace(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	machineClass := &computev1alpha1.MachineClass{}
+	if err := ironcoreClient.Get(ctx, client.ObjectKey{Name: name}, machineClass); err != nil {
+		return nil, fmt.Errorf("failed to get ironcore machine class %s: %w", name, err)
+	}
+
+	capacity := corev1.ResourceList{}
+	if cpu, ok := machineClass.Capabilities[corev1alpha1.ResourceCPU]; ok {
+		capacity[corev1.ResourceCPU] = cpu
+	}
+	if memory, ok := machineClass.Capabilities[corev1alpha1.ResourceMemory]; ok {
+		capacity[corev1.ResourceMemory] = memory
+	}
+	return capacity, nil
+}
+
+// getIroncoreClientAndNamespace returns the client and the namespace of the ironcore credentials of the worker. The
+// client is created on first use and reused for the lifetime of the delegate.
+func (w *workerDelegate) getIroncoreClientAndNamespace(ctx context.Context) (client.Client, string, error) {
+	if w.ironcoreClient == nil {
+		ironcoreClient, namespace, err := ironcore.GetIroncoreClientAndNamespaceFromCloudProviderSecret(ctx, w.client, w.worker.Namespace)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to get ironcore client: %w", err)
+		}
+		w.ironcoreClient = ironcoreClient
+		w.ironcoreNamespace = namespace
+	}
+	return w.ironcoreClient, w.ironcoreNamespace, nil
+}
@@ -17,7 +17,9 @@ import (
 	gardener "github.com/gardener/gardener/pkg/client/kubernetes"
 	machinescheme "github.com/gardener/machine-controller-manager/pkg/client/clientset/versioned/scheme"
 	"github.com/ironcore-dev/controller-utils/modutils"
+	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
 	networkingv1alpha1 "github.com/ironcore-dev/ironcore/api/networking/v1alpha1"
+	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
 	envtestutils "github.com/ironcore-dev/ironcore/utils/envtest"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -115,7 +117,9 @@ var _ = BeforeSuite(func() {
 	Expect(cfg).NotTo(BeNil())
 	DeferCleanup(envtestutils.StopWithExtensions, testEnv, testEnvExt)
 
+	Expect(computev1alpha1.AddToScheme(scheme.Scheme)).To(Succeed())
 	Expect(networkingv1alpha1.AddToScheme(scheme.Scheme)).To(Succeed())
+	Expect(storagev1alpha1.AddToScheme(scheme.Scheme)).To(Succeed())
 	Expect(apiextensionsscheme.AddToScheme(scheme.Scheme)).To(Succeed())
 	Expect(machinescheme.AddToScheme(scheme.Scheme)).To(Succeed())
 	Expect(gardenerextensionv1alpha1.AddToScheme(scheme.Scheme)).To(Succeed())
@@ -170,6 +174,29 @@ func SetupTest() (*corev1.Namespace, *gardener.ChartApplier) {
 		Expect(k8sClient.Create(ctx, userDataSecret)).To(Succeed())
 		DeferCleanup(k8sClient.Delete, userDataSecret)
 
+		user, err := testEnv.AddUser(envtest.User{
+			Name:   "dummy",
+			Groups: []string{"system:authenticated", "system:masters"},
+		}, cfg)
+		Expect(err).NotTo(HaveOccurred())
+
+		kubeconfig, err := user.KubeConfig()
+		Expect(err).NotTo(HaveOccurred())
+
+		cloudProviderSecret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns.Name,
+				Name:      "cloudprovider",
+			},
+			Data: map[string][]byte{
+				"namespace":  []byte(ns.Name),
+				"token":      []byte("foo"),
+				"kubeconfig": kubeconfig,
+			},
+		}
+		Expect(k8sClient.Create(ctx, cloudProviderSecret)).To(Succeed())
+		DeferCleanup(k8sClient.Delete, cloudProviderSecret)
+
 		// define test resources
 		pool = gardenerextensionv1alpha1.WorkerPool{
 			MachineType:    "foo",
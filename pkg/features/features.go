@@ -0,0 +1,28 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package features
+
+import (
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/component-base/featuregate"
+)
+
+const (
+	// NetworkInterfaces allows shoots to configure additional network interfaces in the WorkerConfig of their worker
+	// pools. The admission rejects them while it is disabled. Only enable it if the deployed
+	// machine-controller-manager-provider-ironcore understands the networkInterfaces field of the MachineClass
+	// providerSpec.
+	NetworkInterfaces featuregate.Feature = "NetworkInterfaces"
+)
+
+// ExtensionFeatureGate is the feature gate for the features of the extension.
+var ExtensionFeatureGate = featuregate.NewFeatureGate()
+
+var defaultFeatures = map[featuregate.Feature]featuregate.FeatureSpec{
+	NetworkInterfaces: {Default: false, PreRelease: featuregate.Alpha},
+}
+
+func init() {
+	utilruntime.Must(ExtensionFeatureGate.Add(defaultFeatures))
+}
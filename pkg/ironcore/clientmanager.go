@@ -0,0 +1,228 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package ironcore
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/metrics"
+)
+
+// DefaultClientTTL is the default duration after which a cached ironcore client is recreated.
+const DefaultClientTTL = 10 * time.Minute
+
+// ClientManagerOptions are the options of a ClientManager.
+type ClientManagerOptions struct {
+	// TTL is the duration after which a cached client is recreated even if its secret did not change. Recreating
+	// the client re-evaluates which of the servers in the kubeconfig is reachable. Defaults to DefaultClientTTL.
+	TTL time.Duration
+	// InformerCache enables informer-backed reads for the ironcore namespace of a client. It only takes effect once
+	// the ClientManager has been started.
+	InformerCache bool
+}
+
+// ClientManager caches ironcore clients per credentials secret. A cached client is reused as long as the UID and
+// the resourceVersion of its secret do not change and it is younger than the configured TTL. Clients of the same
+// secret share their REST config, HTTP transport and rate limiter, and record their requests in the ironcore API
+// metrics.
+type ClientManager struct {
+	opts  ClientManagerOptions
+	clock clock.PassiveClock
+
+	mu      sync.Mutex
+	ctx     context.Context
+	entries map[types.NamespacedName]*clientEntry
+}
+
+type clientEntry struct {
+	uid             types.UID
+	resourceVersion string
+	created         time.Time
+
+	client    client.Client
+	namespace string
+	stopCache context.CancelFunc
+}
+
+// NewClientManager creates a new ClientManager with the given options.
+func NewClientManager(opts ClientManagerOptions) *ClientManager {
+	if opts.TTL <= 0 {
+		opts.TTL = DefaultClientTTL
+	}
+	return &ClientManager{
+		opts:    opts,
+		clock:   clock.RealClock{},
+		entries: make(map[types.NamespacedName]*clientEntry),
+	}
+}
+
+// DefaultClientManager is the ClientManager used by GetIroncoreClientAndNamespaceFromCloudProviderSecret and
+// GetIroncoreClientAndNamespaceFromSecretRef.
+var DefaultClientManager = NewClientManager(ClientManagerOptions{})
+
+// Start enables the informer caches of the ClientManager and stops all of them once the given context is done.
+func (m *ClientManager) Start(ctx context.Context) error {
+	m.mu.Lock()
+	m.ctx = ctx
+	m.mu.Unlock()
+
+	<-ctx.Done()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.ctx = nil
+	for key := range m.entries {
+		m.evictLocked(key)
+	}
+	return nil
+}
+
+// GetClient returns the ironcore client and namespace of the given secret. The secret has to contain a kubeconfig
+// and a namespace.
+func (m *ClientManager) GetClient(ctx context.Context, secret *corev1.Secret) (client.Client, string, error) {
+	key := types.NamespacedName{Namespace: secret.Namespace, Name: secret.Name}
+
+	m.mu.Lock()
+	m.evictExpiredLocked()
+	if entry, ok := m.entries[key]; ok && entry.matches(secret) {
+		m.mu.Unlock()
+		return entry.client, entry.namespace, nil
+	}
+	m.mu.Unlock()
+
+	// Build the client without holding the lock, probing the servers of the kubeconfig may take a while.
+	entry, err := m.newEntry(ctx, secret)
+	if err != nil {
+		return nil, "", err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if existing, ok := m.entries[key]; ok && existing.matches(secret) {
+		entry.stop()
+		return existing.client, existing.namespace, nil
+	}
+	m.evictLocked(key)
+	m.entries[key] = entry
+	return entry.client, entry.namespace, nil
+}
+
+// Evict removes the cached client of the secret with the given key.
+func (m *ClientManager) Evict(key types.NamespacedName) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.evictLocked(key)
+}
+
+func (m *ClientManager) newEntry(ctx context.Context, secret *corev1.Secret) (*clientEntry, error) {
+	kubeconfig, ok := secret.Data[KubeConfigFieldName]
+	if !ok {
+		return nil, fmt.Errorf("could not find a kubeconfig in the secret")
+	}
+	namespace, ok := secret.Data[NamespaceFieldName]
+	if !ok {
+		return nil, fmt.Errorf("could not find a namespace in the secret")
+	}
+
+	restConfig, err := restConfigFromKubeconfig(ctx, kubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create rest config from secret: %w", err)
+	}
+	httpClient, err := rest.HTTPClientFor(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create http client from secret: %w", err)
+	}
+
+	entry := &clientEntry{
+		uid:             secret.UID,
+		resourceVersion: secret.ResourceVersion,
+		created:         m.clock.Now(),
+		namespace:       string(namespace),
+	}
+
+	clientOpts := client.Options{Scheme: ironcoreScheme, HTTPClient: httpClient}
+	if cacheCtx := m.cacheContext(); cacheCtx != nil {
+		informerCache, err := cache.New(restConfig, cache.Options{
+			Scheme:            ironcoreScheme,
+			HTTPClient:        httpClient,
+			DefaultNamespaces: map[string]cache.Config{entry.namespace: {}},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create cache from secret: %w", err)
+		}
+		cacheCtx, cancel := context.WithCancel(cacheCtx)
+		go func() {
+			_ = informerCache.Start(cacheCtx)
+		}()
+		entry.stopCache = cancel
+		clientOpts.Cache = &client.CacheOptions{
+			Reader:     informerCache,
+			DisableFor: []client.Object{&corev1.Secret{}},
+		}
+	}
+
+	c, err := client.NewWithWatch(restConfig, clientOpts)
+	if err != nil {
+		entry.stop()
+		return nil, fmt.Errorf("failed to create client from secret: %w", err)
+	}
+	entry.client = metrics.NewClient(c, regionFromKubeconfig(kubeconfig))
+	return entry, nil
+}
+
+// regionFromKubeconfig returns the name of the current context of the given kubeconfig. The kubeconfigs generated by
+// the extension name their current context after the region of the shoot.
+func regionFromKubeconfig(kubeconfig []byte) string {
+	config, err := clientcmd.Load(kubeconfig)
+	if err != nil {
+		return ""
+	}
+	return config.CurrentContext
+}
+
+func (m *ClientManager) cacheContext() context.Context {
+	if !m.opts.InformerCache {
+		return nil
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.ctx
+}
+
+func (m *ClientManager) evictExpiredLocked() {
+	now := m.clock.Now()
+	for key, entry := range m.entries {
+		if now.Sub(entry.created) >= m.opts.TTL {
+			m.evictLocked(key)
+		}
+	}
+}
+
+func (m *ClientManager) evictLocked(key types.NamespacedName) {
+	if entry, ok := m.entries[key]; ok {
+		entry.stop()
+		delete(m.entries, key)
+	}
+}
+
+func (e *clientEntry) matches(secret *corev1.Secret) bool {
+	return e.uid == secret.UID && e.resourceVersion == secret.ResourceVersion
+}
+
+func (e *clientEntry) stop() {
+	if e.stopCache != nil {
+		e.stopCache()
+	}
+}
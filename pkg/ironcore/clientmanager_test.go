@@ -0,0 +1,148 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package ironcore
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	testclock "k8s.io/utils/clock/testing"
+)
+
+var _ = Describe("ClientManager", func() {
+	var (
+		ctx = context.TODO()
+
+		fakeClock *testclock.FakePassiveClock
+		manager   *ClientManager
+		secret    *corev1.Secret
+
+		key = types.NamespacedName{Namespace: "shoot--foo--bar", Name: "cloudprovider"}
+	)
+
+	cachedEntry := func() *clientEntry {
+		return manager.entries[key]
+	}
+
+	BeforeEach(func() {
+		fakeClock = testclock.NewFakePassiveClock(time.Now())
+		manager = NewClientManager(ClientManagerOptions{TTL: time.Minute})
+		manager.clock = fakeClock
+
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:       "shoot--foo--bar",
+				Name:            "cloudprovider",
+				UID:             "uid",
+				ResourceVersion: "1",
+			},
+			Data: map[string][]byte{
+				"kubeconfig": testKubeconfig(nil, "https://localhost"),
+				"namespace":  []byte("foo"),
+			},
+		}
+	})
+
+	It("should reuse the client as long as the secret does not change", func() {
+		_, namespace, err := manager.GetClient(ctx, secret)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(namespace).To(Equal("foo"))
+		first := cachedEntry()
+
+		_, _, err = manager.GetClient(ctx, secret.DeepCopy())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cachedEntry()).To(BeIdenticalTo(first))
+	})
+
+	It("should recreate the client if the resourceVersion of the secret changed", func() {
+		_, _, err := manager.GetClient(ctx, secret)
+		Expect(err).NotTo(HaveOccurred())
+		first := cachedEntry()
+
+		secret.ResourceVersion = "2"
+		secret.Data["namespace"] = []byte("bar")
+		_, namespace, err := manager.GetClient(ctx, secret)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(namespace).To(Equal("bar"))
+		Expect(cachedEntry()).NotTo(BeIdenticalTo(first))
+	})
+
+	It("should recreate the client if the secret was recreated", func() {
+		_, _, err := manager.GetClient(ctx, secret)
+		Expect(err).NotTo(HaveOccurred())
+		first := cachedEntry()
+
+		secret.UID = "other-uid"
+		_, _, err = manager.GetClient(ctx, secret)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cachedEntry()).NotTo(BeIdenticalTo(first))
+	})
+
+	It("should recreate the client after the TTL expired", func() {
+		_, _, err := manager.GetClient(ctx, secret)
+		Expect(err).NotTo(HaveOccurred())
+		first := cachedEntry()
+
+		fakeClock.SetTime(fakeClock.Now().Add(time.Minute))
+		_, _, err = manager.GetClient(ctx, secret)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cachedEntry()).NotTo(BeIdenticalTo(first))
+	})
+
+	It("should recreate the client after it was evicted", func() {
+		_, _, err := manager.GetClient(ctx, secret)
+		Expect(err).NotTo(HaveOccurred())
+		first := cachedEntry()
+
+		manager.Evict(key)
+		_, _, err = manager.GetClient(ctx, secret)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cachedEntry()).NotTo(BeIdenticalTo(first))
+	})
+
+	It("should fail if the secret does not contain a kubeconfig", func() {
+		delete(secret.Data, "kubeconfig")
+		_, _, err := manager.GetClient(ctx, secret)
+		Expect(err).To(MatchError("could not find a kubeconfig in the secret"))
+	})
+
+	It("should fail if the secret does not contain a namespace", func() {
+		delete(secret.Data, "namespace")
+		_, _, err := manager.GetClient(ctx, secret)
+		Expect(err).To(MatchError("could not find a namespace in the secret"))
+	})
+
+	It("should only start informer caches while the manager is running", func() {
+		manager = NewClientManager(ClientManagerOptions{InformerCache: true})
+
+		_, _, err := manager.GetClient(ctx, secret)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cachedEntry()).NotTo(BeNil())
+		Expect(cachedEntry().stopCache).To(BeNil())
+
+		managerCtx, cancel := context.WithCancel(ctx)
+		done := make(chan struct{})
+		go func() {
+			defer GinkgoRecover()
+			defer close(done)
+			Expect(manager.Start(managerCtx)).To(Succeed())
+		}()
+		Eventually(manager.cacheContext).ShouldNot(BeNil())
+
+		secret.ResourceVersion = "2"
+		_, _, err = manager.GetClient(ctx, secret)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cachedEntry()).NotTo(BeNil())
+		Expect(cachedEntry().stopCache).NotTo(BeNil())
+
+		cancel()
+		Eventually(done).Should(BeClosed())
+		Expect(manager.entries).To(BeEmpty())
+	})
+})
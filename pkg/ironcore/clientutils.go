@@ -5,7 +5,9 @@ package ironcore
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
@@ -15,10 +17,15 @@ import (
 	networkingv1alpha1 "github.com/ironcore-dev/ironcore/api/networking/v1alpha1"
 	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
+	clientcmdv1 "k8s.io/client-go/tools/clientcmd/api/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
 )
 
 var ironcoreScheme = runtime.NewScheme()
@@ -33,35 +40,23 @@ func init() {
 }
 
 // GetIroncoreClientAndNamespaceFromCloudProviderSecret extracts the <ironcoreClient, ironcoreNamespace> from the
-// cloudprovider secret in the Shoot namespace.
+// cloudprovider secret in the Shoot namespace. The client is cached by the DefaultClientManager.
 func GetIroncoreClientAndNamespaceFromCloudProviderSecret(ctx context.Context, cl client.Client, shootNamespace string) (client.Client, string, error) {
 	secret := &corev1.Secret{}
 	secretKey := client.ObjectKey{Namespace: shootNamespace, Name: v1beta1constants.SecretNameCloudProvider}
 	if err := cl.Get(ctx, secretKey, secret); err != nil {
 		return nil, "", fmt.Errorf("failed to get cloudprovider secret: %w", err)
 	}
-	kubeconfig, ok := secret.Data["kubeconfig"]
-	if !ok {
-		return nil, "", fmt.Errorf("could not find a kubeconfig in the cloudprovider secret")
-	}
-	namespace, ok := secret.Data["namespace"]
-	if !ok {
-		return nil, "", fmt.Errorf("could not find a namespace in the cloudprovider secret")
-	}
-	clientCfg, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
-	if err != nil {
-		return nil, "", fmt.Errorf("failed to create rest config from cloudprovider secret: %w", err)
-	}
-	c, err := client.New(clientCfg, client.Options{Scheme: ironcoreScheme})
+
+	c, namespace, err := DefaultClientManager.GetClient(ctx, secret)
 	if err != nil {
-		return nil, "", fmt.Errorf("failed to create client from cloudprovider secret: %w", err)
+		return nil, "", fmt.Errorf("failed to get ironcore client for cloudprovider secret: %w", err)
 	}
-
-	return c, string(namespace), nil
+	return c, namespace, nil
 }
 
 // GetIroncoreClientAndNamespaceFromSecretRef extracts the <ironcoreClient, ironcoreNamespace> from the
-// provided secret
+// provided secret. The client is cached by the DefaultClientManager.
 func GetIroncoreClientAndNamespaceFromSecretRef(ctx context.Context, cl client.Client, secretRef *corev1.SecretReference) (client.Client, string, error) {
 	secret, err := extensionscontroller.GetSecretByReference(ctx, cl, secretRef)
 	if err != nil {
@@ -71,22 +66,79 @@ func GetIroncoreClientAndNamespaceFromSecretRef(ctx context.Context, cl client.C
 	if secret.Data == nil {
 		return nil, "", fmt.Errorf("secret does not contain any data")
 	}
-	kubeconfig, ok := secret.Data["kubeconfig"]
-	if !ok {
-		return nil, "", fmt.Errorf("could not find a kubeconfig in the secret")
-	}
-	namespace, ok := secret.Data["namespace"]
-	if !ok {
-		return nil, "", fmt.Errorf("could not find a namespace in the secret")
+	c, namespace, err := DefaultClientManager.GetClient(ctx, secret)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get ironcore client for secret %s: %w", client.ObjectKeyFromObject(secret), err)
 	}
-	clientCfg, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+	return c, namespace, nil
+}
+
+// endpointProbeTimeout is the time after which an unresponsive ironcore API endpoint is skipped.
+var endpointProbeTimeout = 10 * time.Second
+
+// restConfigFromKubeconfig returns the rest config of the first reachable server of the given kubeconfig. The current
+// context is tried first, followed by the remaining contexts in the order they are listed in the kubeconfig. A
+// kubeconfig with a single context is returned without probing its server.
+func restConfigFromKubeconfig(ctx context.Context, kubeconfig []byte) (*rest.Config, error) {
+	config, err := clientcmd.Load(kubeconfig)
 	if err != nil {
-		return nil, "", fmt.Errorf("failed to create rest config from secret: %w", err)
+		return nil, err
+	}
+	if len(config.Contexts) <= 1 {
+		return clientcmd.NewDefaultClientConfig(*config, &clientcmd.ConfigOverrides{}).ClientConfig()
 	}
-	c, err := client.New(clientCfg, client.Options{Scheme: ironcoreScheme})
+
+	contextNames, err := orderedContextNames(kubeconfig)
 	if err != nil {
-		return nil, "", fmt.Errorf("failed to create client from secret: %w", err)
+		return nil, err
 	}
 
-	return c, string(namespace), nil
+	var errs []error
+	for _, contextName := range contextNames {
+		restConfig, err := clientcmd.NewNonInteractiveClientConfig(*config, contextName, &clientcmd.ConfigOverrides{}, nil).ClientConfig()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("context %s: %w", contextName, err))
+			continue
+		}
+		if err := probeEndpoint(ctx, restConfig); err != nil {
+			errs = append(errs, fmt.Errorf("context %s: server %s is not reachable: %w", contextName, restConfig.Host, err))
+			continue
+		}
+		return restConfig, nil
+	}
+	return nil, fmt.Errorf("none of the servers in the kubeconfig is reachable: %w", errors.Join(errs...))
+}
+
+// orderedContextNames returns the name of the current context followed by the names of all other contexts in the
+// order they are listed in the given kubeconfig.
+func orderedContextNames(kubeconfig []byte) ([]string, error) {
+	config := &clientcmdv1.Config{}
+	if err := yaml.Unmarshal(kubeconfig, config); err != nil {
+		return nil, err
+	}
+
+	names := []string{config.CurrentContext}
+	for _, namedContext := range config.Contexts {
+		if namedContext.Name != config.CurrentContext {
+			names = append(names, namedContext.Name)
+		}
+	}
+	return names, nil
+}
+
+// probeEndpoint checks whether the server of the given rest config is reachable. Any response of the server, including
+// authorization errors, counts as reachable.
+func probeEndpoint(ctx context.Context, config *rest.Config) error {
+	ctx, cancel := context.WithTimeout(ctx, endpointProbeTimeout)
+	defer cancel()
+
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(config)
+	if err != nil {
+		return err
+	}
+	err = discoveryClient.RESTClient().Get().AbsPath("/version").Do(ctx).Error()
+	if _, ok := err.(apierrors.APIStatus); ok {
+		return nil
+	}
+	return err
 }
@@ -0,0 +1,148 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package ironcore
+
+import (
+	"context"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	testutils "github.com/gardener/gardener/pkg/utils/test"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientcmdlatest "k8s.io/client-go/tools/clientcmd/api/latest"
+	clientcmdv1 "k8s.io/client-go/tools/clientcmd/api/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("ClientUtils", func() {
+	var (
+		ctx = context.TODO()
+
+		reachableServer *httptest.Server
+		brokenServer    *httptest.Server
+		caData          []byte
+	)
+
+	BeforeEach(func() {
+		reachableServer = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = fmt.Fprint(w, `{"major":"1","minor":"31","gitVersion":"v1.31.0"}`)
+		}))
+		DeferCleanup(reachableServer.Close)
+
+		brokenServer = httptest.NewTLSServer(http.NotFoundHandler())
+		brokenServer.Close()
+
+		caData = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: reachableServer.Certificate().Raw})
+
+		DeferCleanup(testutils.WithVar(&DefaultClientManager, NewClientManager(ClientManagerOptions{})))
+	})
+
+	kubeconfig := func(servers ...string) []byte {
+		return testKubeconfig(caData, servers...)
+	}
+
+	Describe("#restConfigFromKubeconfig", func() {
+		It("should return the server of a single context without probing it", func() {
+			config, err := restConfigFromKubeconfig(ctx, kubeconfig(brokenServer.URL))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config.Host).To(Equal(brokenServer.URL))
+		})
+
+		It("should return the primary server if it is reachable", func() {
+			config, err := restConfigFromKubeconfig(ctx, kubeconfig(reachableServer.URL, brokenServer.URL))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config.Host).To(Equal(reachableServer.URL))
+		})
+
+		It("should fail over to the next reachable server", func() {
+			config, err := restConfigFromKubeconfig(ctx, kubeconfig(brokenServer.URL, brokenServer.URL, reachableServer.URL))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config.Host).To(Equal(reachableServer.URL))
+		})
+
+		It("should treat a server rejecting the credentials as reachable", func() {
+			forbiddenServer := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusForbidden)
+				_, _ = fmt.Fprint(w, `{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"Forbidden","code":403}`)
+			}))
+			forbiddenServer.TLS = reachableServer.TLS
+			forbiddenServer.StartTLS()
+			DeferCleanup(forbiddenServer.Close)
+
+			config, err := restConfigFromKubeconfig(ctx, kubeconfig(forbiddenServer.URL, reachableServer.URL))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config.Host).To(Equal(forbiddenServer.URL))
+		})
+
+		It("should fail if no server is reachable", func() {
+			_, err := restConfigFromKubeconfig(ctx, kubeconfig(brokenServer.URL, brokenServer.URL))
+			Expect(err).To(MatchError(ContainSubstring("none of the servers in the kubeconfig is reachable")))
+		})
+	})
+
+	Describe("#GetIroncoreClientAndNamespaceFromCloudProviderSecret", func() {
+		It("should return a client and the namespace for the first reachable server", func() {
+			cl := fake.NewClientBuilder().WithObjects(&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "shoot--foo--bar", Name: v1beta1constants.SecretNameCloudProvider},
+				Data: map[string][]byte{
+					"kubeconfig": kubeconfig(brokenServer.URL, reachableServer.URL),
+					"namespace":  []byte("foo"),
+				},
+			}).Build()
+
+			ironcoreClient, namespace, err := GetIroncoreClientAndNamespaceFromCloudProviderSecret(ctx, cl, "shoot--foo--bar")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ironcoreClient).NotTo(BeNil())
+			Expect(namespace).To(Equal("foo"))
+		})
+
+		It("should fail if no server of the cloudprovider secret is reachable", func() {
+			cl := fake.NewClientBuilder().WithObjects(&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "shoot--foo--bar", Name: v1beta1constants.SecretNameCloudProvider},
+				Data: map[string][]byte{
+					"kubeconfig": kubeconfig(brokenServer.URL, brokenServer.URL),
+					"namespace":  []byte("foo"),
+				},
+			}).Build()
+
+			_, _, err := GetIroncoreClientAndNamespaceFromCloudProviderSecret(ctx, cl, "shoot--foo--bar")
+			Expect(err).To(MatchError(ContainSubstring("failed to create rest config from secret")))
+		})
+	})
+})
+
+// testKubeconfig returns a kubeconfig with a context for each of the given servers. The first server is used by the
+// current context.
+func testKubeconfig(caData []byte, servers ...string) []byte {
+	config := &clientcmdv1.Config{
+		CurrentContext: "region",
+		AuthInfos:      []clientcmdv1.NamedAuthInfo{{Name: "user", AuthInfo: clientcmdv1.AuthInfo{Token: "token"}}},
+	}
+	for i, server := range servers {
+		name := "region"
+		if i > 0 {
+			name = fmt.Sprintf("region-failover-%d", i)
+		}
+		config.Clusters = append(config.Clusters, clientcmdv1.NamedCluster{
+			Name:    name,
+			Cluster: clientcmdv1.Cluster{Server: server, CertificateAuthorityData: caData},
+		})
+		config.Contexts = append(config.Contexts, clientcmdv1.NamedContext{
+			Name:    name,
+			Context: clientcmdv1.Context{Cluster: name, AuthInfo: "user", Namespace: "foo"},
+		})
+	}
+	raw, err := runtime.Encode(clientcmdlatest.Codec, config)
+	Expect(err).NotTo(HaveOccurred())
+	return raw
+}
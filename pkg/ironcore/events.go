@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package ironcore
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const (
+	// EventReasonResourceCreated is the reason of an Event recorded for a created ironcore resource.
+	EventReasonResourceCreated = "IroncoreResourceCreated"
+	// EventReasonResourcePatched is the reason of an Event recorded for a patched ironcore resource.
+	EventReasonResourcePatched = "IroncoreResourcePatched"
+	// EventReasonResourceDeleted is the reason of an Event recorded for a deleted ironcore resource.
+	EventReasonResourceDeleted = "IroncoreResourceDeleted"
+	// EventReasonConfigurationAdjusted is the reason of an Event recorded if the extension deviates from the
+	// configuration of the user, for example to stay within the limits of the ironcore API.
+	EventReasonConfigurationAdjusted = "ConfigurationAdjusted"
+	// EventReasonMachineClassUnavailable is the reason of an Event recorded if the ironcore MachineClass of a worker
+	// pool cannot be read and the extension falls back to settings which do not depend on it.
+	EventReasonMachineClassUnavailable = "MachineClassUnavailable"
+)
+
+// RecordApplyEvent records an Event on the given extension object if the given ironcore object has been created or
+// patched. Unchanged objects are not recorded.
+func RecordApplyEvent(recorder events.EventRecorder, extensionObj runtime.Object, obj client.Object, result controllerutil.OperationResult) {
+	switch result {
+	case controllerutil.OperationResultCreated:
+		recorder.Eventf(extensionObj, nil, corev1.EventTypeNormal, EventReasonResourceCreated, "Create",
+			"Created %s", describeObject(obj))
+	case controllerutil.OperationResultUpdated, controllerutil.OperationResultUpdatedStatus, controllerutil.OperationResultUpdatedStatusOnly:
+		recorder.Eventf(extensionObj, nil, corev1.EventTypeNormal, EventReasonResourcePatched, "Patch",
+			"Patched %s", describeObject(obj))
+	}
+}
+
+// RecordDeleteEvent records an Event on the given extension object for the deletion of the given ironcore object.
+func RecordDeleteEvent(recorder events.EventRecorder, extensionObj runtime.Object, obj client.Object) {
+	recorder.Eventf(extensionObj, nil, corev1.EventTypeNormal, EventReasonResourceDeleted, "Delete",
+		"Deleted %s", describeObject(obj))
+}
+
+// RecordConfigurationAdjustedEvent records a warning Event on the given extension object which explains how the
+// configuration of the given ironcore object deviates from the configuration of the user.
+func RecordConfigurationAdjustedEvent(recorder events.EventRecorder, extensionObj runtime.Object, obj client.Object, format string, args ...any) {
+	recorder.Eventf(extensionObj, nil, corev1.EventTypeWarning, EventReasonConfigurationAdjusted, "Adjust",
+		"%s: %s", describeObject(obj), fmt.Sprintf(format, args...))
+}
+
+// DeleteAndRecord deletes the given ironcore object and records an Event on the given extension object. The object is
+// read first to record its UID, objects which are already being deleted are skipped. Like client.Delete, a NotFound
+// error is returned if the object does not exist.
+func DeleteAndRecord(ctx context.Context, ironcoreClient client.Client, recorder events.EventRecorder, extensionObj runtime.Object, obj client.Object) error {
+	if err := ironcoreClient.Get(ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
+		return err
+	}
+	if obj.GetDeletionTimestamp() != nil {
+		return nil
+	}
+	if err := ironcoreClient.Delete(ctx, obj, client.Preconditions{UID: ptr.To(obj.GetUID())}); err != nil {
+		return err
+	}
+	RecordDeleteEvent(recorder, extensionObj, obj)
+	return nil
+}
+
+// describeObject returns the kind, the key and the UID of the given object.
+func describeObject(obj client.Object) string {
+	kind := obj.GetObjectKind().GroupVersionKind().Kind
+	if gvk, err := apiutil.GVKForObject(obj, ironcoreScheme); err == nil {
+		kind = gvk.Kind
+	} else if kind == "" {
+		kind = reflect.Indirect(reflect.ValueOf(obj)).Type().Name()
+	}
+	return fmt.Sprintf("%s %s (UID %s)", kind, client.ObjectKeyFromObject(obj), obj.GetUID())
+}
@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package ironcore
+
+import (
+	"context"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	networkingv1alpha1 "github.com/ironcore-dev/ironcore/api/networking/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+var _ = Describe("Events", func() {
+	var (
+		ctx = context.TODO()
+
+		recorder *events.FakeRecorder
+		infra    *extensionsv1alpha1.Infrastructure
+		network  *networkingv1alpha1.Network
+	)
+
+	BeforeEach(func() {
+		recorder = events.NewFakeRecorder(10)
+		infra = &extensionsv1alpha1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "shoot--foo--bar", Name: "bar"},
+		}
+		network = &networkingv1alpha1.Network{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "shoot--foo--bar", UID: "network-uid"},
+		}
+	})
+
+	Describe("#RecordApplyEvent", func() {
+		It("should record an event for a created object", func() {
+			RecordApplyEvent(recorder, infra, network, controllerutil.OperationResultCreated)
+			Expect(recorder.Events).To(Receive(Equal("Normal IroncoreResourceCreated Created Network foo/shoot--foo--bar (UID network-uid)")))
+		})
+
+		It("should record an event for a patched object", func() {
+			RecordApplyEvent(recorder, infra, network, controllerutil.OperationResultUpdated)
+			Expect(recorder.Events).To(Receive(Equal("Normal IroncoreResourcePatched Patched Network foo/shoot--foo--bar (UID network-uid)")))
+		})
+
+		It("should not record an event for an unchanged object", func() {
+			RecordApplyEvent(recorder, infra, network, controllerutil.OperationResultNone)
+			Expect(recorder.Events).NotTo(Receive())
+		})
+	})
+
+	Describe("#RecordConfigurationAdjustedEvent", func() {
+		It("should record a warning event", func() {
+			RecordConfigurationAdjustedEvent(recorder, infra, network, "using %d instead of %d", 64, 128)
+			Expect(recorder.Events).To(Receive(Equal("Warning ConfigurationAdjusted Network foo/shoot--foo--bar (UID network-uid): using 64 instead of 128")))
+		})
+	})
+
+	Describe("#DeleteAndRecord", func() {
+		It("should delete the object and record an event", func() {
+			ironcoreClient := fakeclient.NewClientBuilder().WithScheme(ironcoreScheme).WithObjects(network).Build()
+
+			obj := &networkingv1alpha1.Network{ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "shoot--foo--bar"}}
+			Expect(DeleteAndRecord(ctx, ironcoreClient, recorder, infra, obj)).To(Succeed())
+
+			Expect(ironcoreClient.Get(ctx, client.ObjectKeyFromObject(network), &networkingv1alpha1.Network{})).To(Satisfy(apierrors.IsNotFound))
+			Expect(recorder.Events).To(Receive(Equal("Normal IroncoreResourceDeleted Deleted Network foo/shoot--foo--bar (UID network-uid)")))
+		})
+
+		It("should not record an event for an object which is already being deleted", func() {
+			network.Finalizers = []string{"foo"}
+			network.DeletionTimestamp = ptr.To(metav1.Now())
+			ironcoreClient := fakeclient.NewClientBuilder().WithScheme(ironcoreScheme).WithObjects(network).Build()
+
+			obj := &networkingv1alpha1.Network{ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "shoot--foo--bar"}}
+			Expect(DeleteAndRecord(ctx, ironcoreClient, recorder, infra, obj)).To(Succeed())
+			Expect(recorder.Events).NotTo(Receive())
+		})
+
+		It("should return a NotFound error if the object does not exist", func() {
+			ironcoreClient := fakeclient.NewClientBuilder().WithScheme(ironcoreScheme).Build()
+
+			Expect(DeleteAndRecord(ctx, ironcoreClient, recorder, infra, network)).To(Satisfy(apierrors.IsNotFound))
+			Expect(recorder.Events).NotTo(Receive())
+		})
+	})
+})
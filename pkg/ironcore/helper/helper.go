@@ -4,8 +4,10 @@
 package helper
 
 import (
+	"encoding/json"
 	"fmt"
 
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"k8s.io/utils/ptr"
 
 	api "github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/apis/ironcore"
@@ -43,3 +45,27 @@ func FindImageFromCloudProfile(cloudProfileConfig *api.CloudProfileConfig, image
 
 	return "", fmt.Errorf("could not find an image for name %q and in version %q", imageName, imageVersion)
 }
+
+// IsOverlayEnabled returns whether the given shoot networking uses an overlay. A networking without a provider
+// config is considered to run with overlay, whereas a provider config without an overlay section is not.
+func IsOverlayEnabled(networking *gardencorev1beta1.Networking) (bool, error) {
+	if networking == nil || networking.ProviderConfig == nil {
+		return true, nil
+	}
+
+	detectOverlay := &struct {
+		Overlay *struct {
+			Enabled bool `json:"enabled"`
+		} `json:"overlay,omitempty"`
+	}{}
+	if err := json.Unmarshal(networking.ProviderConfig.Raw, detectOverlay); err != nil {
+		return false, fmt.Errorf("failed to unmarshal network provider config: %w", err)
+	}
+
+	overlay := detectOverlay.Overlay
+	if overlay == nil {
+		return false, nil
+	}
+
+	return overlay.Enabled, nil
+}
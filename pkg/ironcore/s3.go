@@ -0,0 +1,43 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package ironcore
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// S3ConfigFromSecret creates an AWS config for the S3 endpoint and credentials stored under the given keys of the secret.
+func S3ConfigFromSecret(ctx context.Context, secret *corev1.Secret, accessKeyIDKey, secretAccessKeyKey, endpointKey string) (aws.Config, error) {
+	if secret.Data == nil {
+		return aws.Config{}, fmt.Errorf("secret does not contain any data")
+	}
+
+	accessKeyID, ok := secret.Data[accessKeyIDKey]
+	if !ok {
+		return aws.Config{}, fmt.Errorf("missing %q field in secret", accessKeyIDKey)
+	}
+
+	secretAccessKey, ok := secret.Data[secretAccessKeyKey]
+	if !ok {
+		return aws.Config{}, fmt.Errorf("missing %q field in secret", secretAccessKeyKey)
+	}
+
+	endpoint, ok := secret.Data[endpointKey]
+	if !ok {
+		return aws.Config{}, fmt.Errorf("missing %q field in secret", endpointKey)
+	}
+
+	awsCredentials := credentials.NewStaticCredentialsProvider(string(accessKeyID), string(secretAccessKey), "")
+	cfg, err := config.LoadDefaultConfig(ctx, config.WithCredentialsProvider(awsCredentials), config.WithBaseEndpoint(string(endpoint)))
+	if err != nil {
+		return aws.Config{}, fmt.Errorf("failed to create AWS config: %w", err)
+	}
+	return cfg, nil
+}
@@ -21,6 +21,10 @@ const (
 	CSIAttacherImageName = "csi-attacher"
 	// CSIResizerImageName is the name of the csi-resizer image.
 	CSIResizerImageName = "csi-resizer"
+	// CSISnapshotterImageName is the name of the csi-snapshotter image.
+	CSISnapshotterImageName = "csi-snapshotter"
+	// CSISnapshotControllerImageName is the name of the csi-snapshot-controller image.
+	CSISnapshotControllerImageName = "csi-snapshot-controller"
 	// CSINodeDriverRegistrarImageName is the name of the csi-node-driver-registrar image.
 	CSINodeDriverRegistrarImageName = "csi-node-driver-registrar"
 	// CSILivenessProbeImageName is the name of the csi-liveness-probe image.
@@ -40,6 +44,14 @@ const (
 	SecretAccessKey = "secretAccessKey"
 	//Endpoint
 	Endpoint = "endpoint"
+	// ReplicaBucketName is a constant for the key in a backup secret that holds the name of the secondary bucket.
+	ReplicaBucketName = "replicaBucketName"
+	// ReplicaAccessKeyID is a constant for the key in a backup secret that holds the access key id of the secondary bucket.
+	ReplicaAccessKeyID = "replicaAccessKeyID"
+	// ReplicaSecretAccessKey is a constant for the key in a backup secret that holds the secret access key of the secondary bucket.
+	ReplicaSecretAccessKey = "replicaSecretAccessKey"
+	// ReplicaEndpoint is a constant for the key in a backup secret that holds the endpoint of the secondary bucket.
+	ReplicaEndpoint = "replicaEndpoint"
 	// UsernameFieldName is the field in a secret where the namespace is stored at.
 	UsernameFieldName = "username"
 	// NamespaceFieldName is the field in a secret where the namespace is stored at.
@@ -48,12 +60,24 @@ const (
 	KubeConfigFieldName = "kubeconfig"
 	// TokenFieldName is containing the token to access an ironcore cluster.
 	TokenFieldName = "token"
+	// ClientCertificateFieldName is containing the PEM encoded client certificate to access an ironcore cluster.
+	ClientCertificateFieldName = "clientCertificate"
+	// ClientKeyFieldName is containing the PEM encoded key of the client certificate to access an ironcore cluster.
+	ClientKeyFieldName = "clientKey"
 	// NetworkFieldName is the name of network field
 	NetworkFieldName = "networkName"
 	// PrefixFieldName is the name of the prefix field
 	PrefixFieldName = "prefixName"
 	// ClusterFieldName is the name of the cluster field
 	ClusterFieldName = "clusterName"
+	// NATGatewayFieldName is the name of the NAT gateway field
+	NATGatewayFieldName = "natGatewayName"
+	// NetworkPolicyFieldName is the name of the network policy field
+	NetworkPolicyFieldName = "networkPolicyName"
+	// RegionFieldName is the name of the region field
+	RegionFieldName = "region"
+	// ZonesFieldName is the name of the zones field
+	ZonesFieldName = "zones"
 	// LabelsFieldName is the name of the labels field
 	LabelsFieldName = "labels"
 	// UserDataFieldName is the name of the user data field
@@ -66,6 +90,20 @@ const (
 	SizeFieldName = "size"
 	// VolumeClassFieldName is the name of the volume class field
 	VolumeClassFieldName = "volumeClassName"
+	// MachinePoolSelectorFieldName is the name of the machine pool selector field
+	MachinePoolSelectorFieldName = "machinePoolSelector"
+	// PodPrefixFieldName is the name of the pod prefix field
+	PodPrefixFieldName = "podPrefix"
+	// PodPrefixModeFieldName is the name of the pod prefix mode field
+	PodPrefixModeFieldName = "mode"
+	// PrefixLengthFieldName is the name of the prefix length field
+	PrefixLengthFieldName = "prefixLength"
+	// NetworkInterfacesFieldName is the name of the additional network interfaces field
+	NetworkInterfacesFieldName = "networkInterfaces"
+	// NameFieldName is the name of the name field
+	NameFieldName = "name"
+	// PrefixesFieldName is the name of the prefixes field
+	PrefixesFieldName = "prefixes"
 	// ClusterNameLabel is the name is the label key of the cluster name
 	ClusterNameLabel = "extension.ironcore.dev/cluster-name"
 
@@ -87,6 +125,10 @@ const (
 	CSIAttacherName = "csi-attacher"
 	// CSIResizerName is a constant for the name of the csi-resizer component.
 	CSIResizerName = "csi-resizer"
+	// CSISnapshotterName is a constant for the name of the csi-snapshotter component.
+	CSISnapshotterName = "csi-snapshotter"
+	// CSISnapshotControllerName is a constant for the name of the csi-snapshot-controller component.
+	CSISnapshotControllerName = "csi-snapshot-controller"
 	// CSINodeDriverRegistrarName is a constant for the name of the csi-node-driver-registrar component.
 	CSINodeDriverRegistrarName = "csi-node-driver-registrar"
 	// CSILivenessProbeName is a constant for the name of the csi-liveness-probe component.
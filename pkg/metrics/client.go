@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+// NewClient wraps the given ironcore client so that every request is recorded in the ironcore API metrics with the
+// given region.
+func NewClient(c client.WithWatch, region string) client.WithWatch {
+	observe := func(cl client.Client, obj runtime.Object, operation string, fn func() error) error {
+		start := time.Now()
+		err := fn()
+		ObserveAPIRequest(kindOf(cl, obj), operation, region, start, err)
+		return err
+	}
+
+	return interceptor.NewClient(c, interceptor.Funcs{
+		Get: func(ctx context.Context, cl client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+			return observe(cl, obj, "get", func() error { return cl.Get(ctx, key, obj, opts...) })
+		},
+		List: func(ctx context.Context, cl client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+			return observe(cl, list, "list", func() error { return cl.List(ctx, list, opts...) })
+		},
+		Create: func(ctx context.Context, cl client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+			return observe(cl, obj, "create", func() error { return cl.Create(ctx, obj, opts...) })
+		},
+		Delete: func(ctx context.Context, cl client.WithWatch, obj client.Object, opts ...client.DeleteOption) error {
+			return observe(cl, obj, "delete", func() error { return cl.Delete(ctx, obj, opts...) })
+		},
+		DeleteAllOf: func(ctx context.Context, cl client.WithWatch, obj client.Object, opts ...client.DeleteAllOfOption) error {
+			return observe(cl, obj, "deletecollection", func() error { return cl.DeleteAllOf(ctx, obj, opts...) })
+		},
+		Update: func(ctx context.Context, cl client.WithWatch, obj client.Object, opts ...client.UpdateOption) error {
+			return observe(cl, obj, "update", func() error { return cl.Update(ctx, obj, opts...) })
+		},
+		Patch: func(ctx context.Context, cl client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+			return observe(cl, obj, "patch", func() error { return cl.Patch(ctx, obj, patch, opts...) })
+		},
+		Apply: func(ctx context.Context, cl client.WithWatch, obj runtime.ApplyConfiguration, opts ...client.ApplyOption) error {
+			return observe(cl, nil, "apply", func() error { return cl.Apply(ctx, obj, opts...) })
+		},
+		SubResourceGet: func(ctx context.Context, cl client.Client, subResourceName string, obj client.Object, subResource client.Object, opts ...client.SubResourceGetOption) error {
+			return observe(cl, obj, "get/"+subResourceName, func() error {
+				return cl.SubResource(subResourceName).Get(ctx, obj, subResource, opts...)
+			})
+		},
+		SubResourceCreate: func(ctx context.Context, cl client.Client, subResourceName string, obj client.Object, subResource client.Object, opts ...client.SubResourceCreateOption) error {
+			return observe(cl, obj, "create/"+subResourceName, func() error {
+				return cl.SubResource(subResourceName).Create(ctx, obj, subResource, opts...)
+			})
+		},
+		SubResourceUpdate: func(ctx context.Context, cl client.Client, subResourceName string, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+			return observe(cl, obj, "update/"+subResourceName, func() error {
+				return cl.SubResource(subResourceName).Update(ctx, obj, opts...)
+			})
+		},
+		SubResourcePatch: func(ctx context.Context, cl client.Client, subResourceName string, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+			return observe(cl, obj, "patch/"+subResourceName, func() error {
+				return cl.SubResource(subResourceName).Patch(ctx, obj, patch, opts...)
+			})
+		},
+		SubResourceApply: func(ctx context.Context, cl client.Client, subResourceName string, obj runtime.ApplyConfiguration, opts ...client.SubResourceApplyOption) error {
+			return observe(cl, nil, "apply/"+subResourceName, func() error {
+				return cl.SubResource(subResourceName).Apply(ctx, obj, opts...)
+			})
+		},
+	})
+}
+
+// kindOf returns the kind of the given object. The kind of a list is the kind of its items.
+func kindOf(cl client.Client, obj runtime.Object) string {
+	if obj == nil {
+		return "unknown"
+	}
+	gvk, err := apiutil.GVKForObject(obj, cl.Scheme())
+	if err != nil {
+		return "unknown"
+	}
+	return strings.TrimSuffix(gvk.Kind, "List")
+}
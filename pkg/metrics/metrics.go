@@ -0,0 +1,114 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	namespace = "gardener_extension_provider_ironcore"
+
+	// CodeSuccess is the code label of successful ironcore API requests.
+	CodeSuccess = "success"
+	// CodeUnknown is the code label of failed ironcore API requests which did not return an API status.
+	CodeUnknown = "unknown"
+
+	// ResultSuccess is the result label of successful actuator operations.
+	ResultSuccess = "success"
+	// ResultError is the result label of failed actuator operations.
+	ResultError = "error"
+)
+
+// Actuator operations used as operation label of the reconcile metrics.
+const (
+	OperationReconcile   = "reconcile"
+	OperationDelete      = "delete"
+	OperationForceDelete = "force-delete"
+	OperationMigrate     = "migrate"
+	OperationRestore     = "restore"
+)
+
+var (
+	// APIRequestsTotal counts the requests against the ironcore API.
+	APIRequestsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "ironcore_api",
+		Name:      "requests_total",
+		Help:      "Total number of requests against the ironcore API by resource kind, operation, region and code.",
+	}, []string{"kind", "operation", "region", "code"})
+
+	// APIRequestDuration observes the latency of requests against the ironcore API.
+	APIRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "ironcore_api",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of requests against the ironcore API by resource kind, operation and region.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"kind", "operation", "region"})
+
+	// ReconcileDuration observes the duration of actuator operations.
+	ReconcileDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "actuator",
+		Name:      "duration_seconds",
+		Help:      "Duration of actuator operations by controller, operation and result.",
+		Buckets:   []float64{0.1, 0.5, 1, 2.5, 5, 10, 30, 60, 120, 300, 600},
+	}, []string{"controller", "operation", "result"})
+
+	// ReconcileErrorsTotal counts the failed actuator operations.
+	ReconcileErrorsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "actuator",
+		Name:      "errors_total",
+		Help:      "Total number of failed actuator operations by controller and operation.",
+	}, []string{"controller", "operation"})
+)
+
+func init() {
+	ctrlmetrics.Registry.MustRegister(
+		APIRequestsTotal,
+		APIRequestDuration,
+		ReconcileDuration,
+		ReconcileErrorsTotal,
+	)
+}
+
+// ObserveAPIRequest records an ironcore API request of the given kind and operation which started at the given time.
+func ObserveAPIRequest(kind, operation, region string, start time.Time, err error) {
+	APIRequestDuration.WithLabelValues(kind, operation, region).Observe(time.Since(start).Seconds())
+	APIRequestsTotal.WithLabelValues(kind, operation, region, codeForError(err)).Inc()
+}
+
+// ObserveActuator runs the given actuator operation of the given controller and records its duration and result.
+// It returns the error of the operation.
+func ObserveActuator(controller, operation string, fn func() error) error {
+	start := time.Now()
+	err := fn()
+
+	result := ResultSuccess
+	if err != nil {
+		result = ResultError
+		ReconcileErrorsTotal.WithLabelValues(controller, operation).Inc()
+	}
+	ReconcileDuration.WithLabelValues(controller, operation, result).Observe(time.Since(start).Seconds())
+	return err
+}
+
+func codeForError(err error) string {
+	if err == nil {
+		return CodeSuccess
+	}
+	var status apierrors.APIStatus
+	if errors.As(err, &status) && status.Status().Code != 0 {
+		return strconv.Itoa(int(status.Status().Code))
+	}
+	return CodeUnknown
+}
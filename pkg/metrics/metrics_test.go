@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics_test
+
+import (
+	"context"
+	"errors"
+
+	networkingv1alpha1 "github.com/ironcore-dev/ironcore/api/networking/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/metrics"
+)
+
+var _ = Describe("Metrics", func() {
+	var ctx = context.TODO()
+
+	BeforeEach(func() {
+		APIRequestsTotal.Reset()
+		APIRequestDuration.Reset()
+		ReconcileDuration.Reset()
+		ReconcileErrorsTotal.Reset()
+	})
+
+	Describe("#NewClient", func() {
+		var c client.Client
+
+		BeforeEach(func() {
+			scheme := runtime.NewScheme()
+			Expect(networkingv1alpha1.AddToScheme(scheme)).To(Succeed())
+			c = NewClient(fake.NewClientBuilder().WithScheme(scheme).WithStatusSubresource(&networkingv1alpha1.Network{}).Build(), "my-region")
+		})
+
+		It("should record successful and failed requests per kind, operation and region", func() {
+			network := &networkingv1alpha1.Network{ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "bar"}}
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(network), &networkingv1alpha1.Network{})).NotTo(Succeed())
+			Expect(c.Create(ctx, network)).To(Succeed())
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(network), &networkingv1alpha1.Network{})).To(Succeed())
+			Expect(c.List(ctx, &networkingv1alpha1.NetworkList{}, client.InNamespace("foo"))).To(Succeed())
+
+			Expect(counterValue(APIRequestsTotal.WithLabelValues("Network", "get", "my-region", "404"))).To(Equal(1.0))
+			Expect(counterValue(APIRequestsTotal.WithLabelValues("Network", "get", "my-region", CodeSuccess))).To(Equal(1.0))
+			Expect(counterValue(APIRequestsTotal.WithLabelValues("Network", "create", "my-region", CodeSuccess))).To(Equal(1.0))
+			Expect(counterValue(APIRequestsTotal.WithLabelValues("Network", "list", "my-region", CodeSuccess))).To(Equal(1.0))
+			Expect(sampleCount(APIRequestDuration.WithLabelValues("Network", "get", "my-region"))).To(Equal(uint64(2)))
+		})
+
+		It("should record status updates as subresource operations", func() {
+			network := &networkingv1alpha1.Network{ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "bar"}}
+			Expect(c.Create(ctx, network)).To(Succeed())
+
+			Expect(c.Status().Update(ctx, network)).To(Succeed())
+
+			Expect(counterValue(APIRequestsTotal.WithLabelValues("Network", "update/status", "my-region", CodeSuccess))).To(Equal(1.0))
+		})
+	})
+
+	Describe("#ObserveActuator", func() {
+		It("should record the duration of successful operations", func() {
+			Expect(ObserveActuator("infrastructure", OperationReconcile, func() error { return nil })).To(Succeed())
+
+			Expect(sampleCount(ReconcileDuration.WithLabelValues("infrastructure", OperationReconcile, ResultSuccess))).To(Equal(uint64(1)))
+			Expect(counterValue(ReconcileErrorsTotal.WithLabelValues("infrastructure", OperationReconcile))).To(BeZero())
+		})
+
+		It("should record failed operations and return their error", func() {
+			err := errors.New("boom")
+
+			Expect(ObserveActuator("infrastructure", OperationDelete, func() error { return err })).To(MatchError(err))
+
+			Expect(counterValue(ReconcileErrorsTotal.WithLabelValues("infrastructure", OperationDelete))).To(Equal(1.0))
+			Expect(sampleCount(ReconcileDuration.WithLabelValues("infrastructure", OperationDelete, ResultError))).To(Equal(uint64(1)))
+		})
+	})
+})
+
+func counterValue(counter prometheus.Counter) float64 {
+	metric := &dto.Metric{}
+	Expect(counter.Write(metric)).To(Succeed())
+	return metric.GetCounter().GetValue()
+}
+
+func sampleCount(observer prometheus.Observer) uint64 {
+	metric := &dto.Metric{}
+	Expect(observer.(prometheus.Metric).Write(metric)).To(Succeed())
+	return metric.GetHistogram().GetSampleCount()
+}
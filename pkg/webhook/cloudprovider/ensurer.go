@@ -6,19 +6,24 @@ package cloudprovider
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/gardener/gardener/extensions/pkg/webhook/cloudprovider"
 	gcontext "github.com/gardener/gardener/extensions/pkg/webhook/context"
+	securityv1alpha1constants "github.com/gardener/gardener/pkg/apis/security/v1alpha1/constants"
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	clientcmdlatest "k8s.io/client-go/tools/clientcmd/api/latest"
 	clientcmdv1 "k8s.io/client-go/tools/clientcmd/api/v1"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	apisironcore "github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/apis/ironcore"
+	ironcorevalidation "github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/apis/ironcore/validation"
 	"github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/ironcore"
 )
 
@@ -40,72 +45,176 @@ type ensurer struct {
 // EnsureCloudProviderSecret ensures that cloudprovider secret contains
 // the shared credentials file.
 func (e *ensurer) EnsureCloudProviderSecret(ctx context.Context, gctx gcontext.GardenContext, newCloudProviderSecret, _ *corev1.Secret) error {
-	token, ok := newCloudProviderSecret.Data[ironcore.TokenFieldName]
-	if !ok {
-		return fmt.Errorf("could not mutate cloudprovider secret as %q field is missing", ironcore.TokenFieldName)
+	cluster, err := gctx.GetCluster(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get cluster: %w", err)
+	}
+
+	region, err := e.findRegionConfig(cluster.CloudProfile.Spec.ProviderConfig, cluster.Shoot.Spec.Region)
+	if err != nil {
+		return err
+	}
+
+	if newCloudProviderSecret.Labels[securityv1alpha1constants.LabelPurpose] == securityv1alpha1constants.LabelPurposeWorkloadIdentityTokenRequestor {
+		return e.ensureWorkloadIdentityKubeconfig(newCloudProviderSecret, region)
 	}
+
 	namespace, ok := newCloudProviderSecret.Data[ironcore.NamespaceFieldName]
 	if !ok {
 		return fmt.Errorf("could not mutate cloudprovider secret as %q field is missing", ironcore.NamespaceFieldName)
 	}
-	username, ok := newCloudProviderSecret.Data[ironcore.UsernameFieldName]
-	if !ok {
-		return fmt.Errorf("could not mutate cloud provider secret as %q fied is missing", ironcore.UsernameFieldName)
+
+	userName, authInfo, err := authInfoFromSecret(newCloudProviderSecret, region)
+	if err != nil {
+		return fmt.Errorf("could not mutate cloudprovider secret: %w", err)
 	}
 
-	cluster, err := gctx.GetCluster(ctx)
+	raw, err := buildKubeconfig(region, string(namespace), userName, authInfo)
 	if err != nil {
-		return fmt.Errorf("failed to get cluster: %w", err)
+		return err
+	}
+
+	newCloudProviderSecret.Data[ironcore.KubeConfigFieldName] = raw
+	return nil
+}
+
+// ensureWorkloadIdentityKubeconfig builds the kubeconfig of a cloudprovider secret which is managed by the Gardener
+// token requestor. The ironcore API server trusts the Gardener issuer, so the shoot-scoped token of the workload
+// identity is presented as bearer token. Until the token is requested, the secret is left untouched.
+func (e *ensurer) ensureWorkloadIdentityKubeconfig(secret *corev1.Secret, region *apisironcore.RegionConfig) error {
+	workloadIdentityConfig := &apisironcore.WorkloadIdentityConfig{}
+	if _, _, err := e.decoder.Decode(secret.Data[securityv1alpha1constants.DataKeyConfig], nil, workloadIdentityConfig); err != nil {
+		return fmt.Errorf("could not decode workload identity config: %w", err)
+	}
+	if secret.Data == nil {
+		secret.Data = map[string][]byte{}
+	}
+	secret.Data[ironcore.NamespaceFieldName] = []byte(workloadIdentityConfig.Namespace)
+
+	token, ok := secret.Data[securityv1alpha1constants.DataKeyToken]
+	if !ok || len(token) == 0 {
+		return nil
 	}
 
+	raw, err := buildKubeconfig(region, workloadIdentityConfig.Namespace, "workload-identity", clientcmdv1.AuthInfo{
+		Token: string(token),
+	})
+	if err != nil {
+		return err
+	}
+
+	secret.Data[ironcore.KubeConfigFieldName] = raw
+	return nil
+}
+
+func (e *ensurer) findRegionConfig(providerConfig *runtime.RawExtension, regionName string) (*apisironcore.RegionConfig, error) {
 	cloudProfileConfig := &apisironcore.CloudProfileConfig{}
-	raw, err := cluster.CloudProfile.Spec.ProviderConfig.MarshalJSON()
+	raw, err := providerConfig.MarshalJSON()
 	if err != nil {
-		return fmt.Errorf("could not decode cluster object's providerConfig: %w", err)
+		return nil, fmt.Errorf("could not decode cluster object's providerConfig: %w", err)
 	}
 	if _, _, err := e.decoder.Decode(raw, nil, cloudProfileConfig); err != nil {
-		return fmt.Errorf("could not decode cluster object's providerConfig: %w", err)
+		return nil, fmt.Errorf("could not decode cluster object's providerConfig: %w", err)
 	}
 
+	for _, region := range cloudProfileConfig.RegionConfigs {
+		if region.Name == regionName {
+			return &region, nil
+		}
+	}
+	return nil, fmt.Errorf("failed to find region %s in cloudprofile", regionName)
+}
+
+// authInfoFromSecret returns the user of the kubeconfig from either the token, the client certificate or the
+// kubeconfig of the secret. A kubeconfig has to point to the server of the region.
+func authInfoFromSecret(secret *corev1.Secret, region *apisironcore.RegionConfig) (string, clientcmdv1.AuthInfo, error) {
+	if token, ok := secret.Data[ironcore.TokenFieldName]; ok {
+		username, ok := secret.Data[ironcore.UsernameFieldName]
+		if !ok {
+			return "", clientcmdv1.AuthInfo{}, fmt.Errorf("%q field is missing", ironcore.UsernameFieldName)
+		}
+		return string(username), clientcmdv1.AuthInfo{Token: string(token)}, nil
+	}
+
+	if certificate, ok := secret.Data[ironcore.ClientCertificateFieldName]; ok {
+		key, ok := secret.Data[ironcore.ClientKeyFieldName]
+		if !ok {
+			return "", clientcmdv1.AuthInfo{}, fmt.Errorf("%q field is missing", ironcore.ClientKeyFieldName)
+		}
+		username := "client-certificate"
+		if name, ok := secret.Data[ironcore.UsernameFieldName]; ok {
+			username = string(name)
+		}
+		return username, clientcmdv1.AuthInfo{ClientCertificateData: certificate, ClientKeyData: key}, nil
+	}
+
+	if kubeconfig, ok := secret.Data[ironcore.KubeConfigFieldName]; ok {
+		config, err := ironcorevalidation.ValidateKubeconfig(kubeconfig)
+		if err != nil {
+			return "", clientcmdv1.AuthInfo{}, fmt.Errorf("invalid %q field: %w", ironcore.KubeConfigFieldName, err)
+		}
+		kubeContext := config.Contexts[config.CurrentContext]
+		if server := config.Clusters[kubeContext.Cluster].Server; !slices.ContainsFunc(regionServers(region), func(regionServer string) bool {
+			return strings.TrimSuffix(server, "/") == strings.TrimSuffix(regionServer, "/")
+		}) {
+			return "", clientcmdv1.AuthInfo{}, fmt.Errorf("server %q of the kubeconfig does not match any server of region %s", server, region.Name)
+		}
+		authInfo := config.AuthInfos[kubeContext.AuthInfo]
+		return kubeContext.AuthInfo, clientcmdv1.AuthInfo{
+			Token:                 authInfo.Token,
+			ClientCertificateData: authInfo.ClientCertificateData,
+			ClientKeyData:         authInfo.ClientKeyData,
+		}, nil
+	}
+
+	return "", clientcmdv1.AuthInfo{}, fmt.Errorf("one of the %q, %q or %q fields is required",
+		ironcore.TokenFieldName, ironcore.ClientCertificateFieldName, ironcore.KubeConfigFieldName)
+}
+
+// regionServers returns the server of the given region followed by its failover servers.
+func regionServers(region *apisironcore.RegionConfig) []string {
+	return append([]string{region.Server}, region.FailoverServers...)
+}
+
+// buildKubeconfig returns a kubeconfig for the servers of the given region which authenticates with the given user.
+// The current context points to the primary server of the region, every failover server gets an additional context
+// named after the region and the position of the server. Clients try the contexts in the order they are listed.
+func buildKubeconfig(region *apisironcore.RegionConfig, namespace, userName string, authInfo clientcmdv1.AuthInfo) ([]byte, error) {
 	kubeconfig := &clientcmdv1.Config{
-		CurrentContext: cluster.Shoot.Spec.Region,
-		Clusters: []clientcmdv1.NamedCluster{{
-			Name: cluster.Shoot.Spec.Region,
-		}},
+		CurrentContext: region.Name,
 		AuthInfos: []clientcmdv1.NamedAuthInfo{{
-			Name: string(username),
-			AuthInfo: clientcmdv1.AuthInfo{
-				Token: string(token),
-			},
-		}},
-		Contexts: []clientcmdv1.NamedContext{{
-			Name: cluster.Shoot.Spec.Region,
-			Context: clientcmdv1.Context{
-				Cluster:   cluster.Shoot.Spec.Region,
-				AuthInfo:  string(username),
-				Namespace: string(namespace),
-			},
+			Name:     userName,
+			AuthInfo: authInfo,
 		}},
 	}
 
-	var regionFound bool
-	for _, region := range cloudProfileConfig.RegionConfigs {
-		if region.Name == cluster.Shoot.Spec.Region {
-			kubeconfig.Clusters[0].Cluster.Server = region.Server
-			kubeconfig.Clusters[0].Cluster.CertificateAuthorityData = region.CertificateAuthorityData
-			regionFound = true
-			break
+	for i, server := range regionServers(region) {
+		name := region.Name
+		if i > 0 {
+			name = fmt.Sprintf("%s-failover-%d", region.Name, i)
 		}
-	}
-	if !regionFound {
-		return fmt.Errorf("faild to find region %s in cloudprofile", cluster.Shoot.Spec.Region)
+		kubeconfig.Clusters = append(kubeconfig.Clusters, clientcmdv1.NamedCluster{
+			Name: name,
+			Cluster: clientcmdv1.Cluster{
+				Server:                   server,
+				CertificateAuthorityData: region.CertificateAuthorityData,
+				ProxyURL:                 ptr.Deref(region.ProxyURL, ""),
+				TLSServerName:            ptr.Deref(region.TLSServerName, ""),
+			},
+		})
+		kubeconfig.Contexts = append(kubeconfig.Contexts, clientcmdv1.NamedContext{
+			Name: name,
+			Context: clientcmdv1.Context{
+				Cluster:   name,
+				AuthInfo:  userName,
+				Namespace: namespace,
+			},
+		})
 	}
 
-	raw, err = runtime.Encode(clientcmdlatest.Codec, kubeconfig)
+	raw, err := runtime.Encode(clientcmdlatest.Codec, kubeconfig)
 	if err != nil {
-		return fmt.Errorf("failed to encode kubeconfig: %w", err)
+		return nil, fmt.Errorf("failed to encode kubeconfig: %w", err)
 	}
-
-	newCloudProviderSecret.Data[ironcore.KubeConfigFieldName] = raw
-	return nil
+	return raw, nil
 }
@@ -5,6 +5,7 @@ package cloudprovider
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
@@ -18,9 +19,11 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/utils/ptr"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	api "github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/apis/ironcore"
+	"github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/apis/ironcore/install"
 )
 
 const namespace = "test"
@@ -70,7 +73,8 @@ var _ = Describe("Ensurer", func() {
 	)
 
 	BeforeEach(func() {
-		scheme = &runtime.Scheme{}
+		scheme = runtime.NewScheme()
+		Expect(install.AddToScheme(scheme)).To(Succeed())
 		mgr = &test.FakeManager{
 			Client: fakeclient.NewClientBuilder().WithScheme(scheme).Build(),
 			Scheme: scheme,
@@ -161,5 +165,171 @@ var _ = Describe("Ensurer", func() {
 			err := ensurer.EnsureCloudProviderSecret(ctx, eContextK8s, secretWithoutUsername, nil)
 			Expect(err).To(HaveOccurred())
 		})
+
+		It("should add a kubeconfig for client certificate credentials", func() {
+			secret.Data = map[string][]byte{
+				"namespace":         []byte("foo"),
+				"clientCertificate": []byte("cert"),
+				"clientKey":         []byte("key"),
+			}
+
+			Expect(ensurer.EnsureCloudProviderSecret(ctx, eContextK8s, secret, nil)).To(Succeed())
+
+			config, err := clientcmd.Load(secret.Data["kubeconfig"])
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config.Clusters[config.CurrentContext].Server).To(Equal("https://localhost"))
+			Expect(config.Contexts[config.CurrentContext].Namespace).To(Equal("foo"))
+			authInfo := config.AuthInfos[config.Contexts[config.CurrentContext].AuthInfo]
+			Expect(authInfo.ClientCertificateData).To(Equal([]byte("cert")))
+			Expect(authInfo.ClientKeyData).To(Equal([]byte("key")))
+		})
+
+		It("should normalize a user supplied kubeconfig for the server of the region", func() {
+			secret.Data = map[string][]byte{
+				"namespace":  []byte("foo"),
+				"kubeconfig": userKubeconfig("https://localhost/", "token: user-token"),
+			}
+
+			Expect(ensurer.EnsureCloudProviderSecret(ctx, eContextK8s, secret, nil)).To(Succeed())
+
+			config, err := clientcmd.Load(secret.Data["kubeconfig"])
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config.Clusters[config.CurrentContext].Server).To(Equal("https://localhost"))
+			Expect(config.Clusters[config.CurrentContext].CertificateAuthorityData).To(Equal([]byte("abcd1234")))
+			Expect(config.Contexts[config.CurrentContext].Namespace).To(Equal("foo"))
+			Expect(config.AuthInfos["user"].Token).To(Equal("user-token"))
+		})
+
+		It("should fail if a user supplied kubeconfig points to an unknown server", func() {
+			secret.Data = map[string][]byte{
+				"namespace":  []byte("foo"),
+				"kubeconfig": userKubeconfig("https://evil.example.com", "token: user-token"),
+			}
+
+			Expect(ensurer.EnsureCloudProviderSecret(ctx, eContextK8s, secret, nil)).To(MatchError(ContainSubstring("does not match any server of region foo")))
+		})
+
+		It("should add a context for every failover server of the region", func() {
+			failoverContext := gcontext.NewInternalGardenContext(
+				&extensionscontroller.Cluster{
+					CloudProfile: &gardencorev1beta1.CloudProfile{
+						Spec: gardencorev1beta1.CloudProfileSpec{
+							ProviderConfig: &runtime.RawExtension{
+								Object: &api.CloudProfileConfig{
+									RegionConfigs: []api.RegionConfig{{
+										Name:                     "foo",
+										Server:                   "https://localhost",
+										CertificateAuthorityData: []byte("abcd1234"),
+										FailoverServers:          []string{"https://failover-1", "https://failover-2"},
+										ProxyURL:                 ptr.To("http://proxy:3128"),
+										TLSServerName:            ptr.To("api.foo"),
+									}},
+								},
+							},
+						},
+					},
+					Shoot: &gardencorev1beta1.Shoot{
+						Spec: gardencorev1beta1.ShootSpec{Region: "foo"},
+					},
+				},
+			)
+			secret.Data = map[string][]byte{
+				"namespace":  []byte("foo"),
+				"kubeconfig": userKubeconfig("https://failover-2", "token: user-token"),
+			}
+
+			Expect(ensurer.EnsureCloudProviderSecret(ctx, failoverContext, secret, nil)).To(Succeed())
+
+			config, err := clientcmd.Load(secret.Data["kubeconfig"])
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config.CurrentContext).To(Equal("foo"))
+			Expect(config.Contexts).To(HaveLen(3))
+			for name, server := range map[string]string{
+				"foo":            "https://localhost",
+				"foo-failover-1": "https://failover-1",
+				"foo-failover-2": "https://failover-2",
+			} {
+				Expect(config.Contexts).To(HaveKey(name))
+				Expect(config.Contexts[name].Namespace).To(Equal("foo"))
+				Expect(config.Contexts[name].AuthInfo).To(Equal("user"))
+				cluster := config.Clusters[config.Contexts[name].Cluster]
+				Expect(cluster.Server).To(Equal(server))
+				Expect(cluster.CertificateAuthorityData).To(Equal([]byte("abcd1234")))
+				Expect(cluster.ProxyURL).To(Equal("http://proxy:3128"))
+				Expect(cluster.TLSServerName).To(Equal("api.foo"))
+			}
+		})
+
+		It("should fail if a user supplied kubeconfig uses a credential plugin", func() {
+			secret.Data = map[string][]byte{
+				"namespace":  []byte("foo"),
+				"kubeconfig": userKubeconfig("https://localhost", "exec:\n      apiVersion: client.authentication.k8s.io/v1\n      command: /bin/sh"),
+			}
+
+			Expect(ensurer.EnsureCloudProviderSecret(ctx, eContextK8s, secret, nil)).To(MatchError(ContainSubstring("must not use a credential plugin")))
+		})
+
+		Context("workload identity", func() {
+			BeforeEach(func() {
+				secret = &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: namespace,
+						Name:      "cloudprovider",
+						Labels: map[string]string{
+							"security.gardener.cloud/purpose": "workload-identity-token-requestor",
+						},
+					},
+					Data: map[string][]byte{
+						"config": []byte(`{"apiVersion":"ironcore.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkloadIdentityConfig","namespace":"foo"}`),
+					},
+				}
+			})
+
+			It("should only set the namespace as long as no token was requested", func() {
+				Expect(ensurer.EnsureCloudProviderSecret(ctx, eContextK8s, secret, nil)).To(Succeed())
+
+				Expect(secret.Data).To(HaveKeyWithValue("namespace", []byte("foo")))
+				Expect(secret.Data).NotTo(HaveKey("kubeconfig"))
+			})
+
+			It("should use the workload identity token in the kubeconfig", func() {
+				secret.Data["token"] = []byte("shoot-scoped-token")
+
+				Expect(ensurer.EnsureCloudProviderSecret(ctx, eContextK8s, secret, nil)).To(Succeed())
+
+				Expect(secret.Data).To(HaveKeyWithValue("namespace", []byte("foo")))
+				config, err := clientcmd.Load(secret.Data["kubeconfig"])
+				Expect(err).NotTo(HaveOccurred())
+				Expect(config.Clusters[config.CurrentContext].Server).To(Equal("https://localhost"))
+				Expect(config.Contexts[config.CurrentContext].Namespace).To(Equal("foo"))
+				Expect(config.AuthInfos[config.Contexts[config.CurrentContext].AuthInfo].Token).To(Equal("shoot-scoped-token"))
+			})
+
+			It("should fail if the workload identity config is invalid", func() {
+				secret.Data["config"] = []byte(`{"apiVersion":"ironcore.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkloadIdentityConfig","unknown":"foo"}`)
+
+				Expect(ensurer.EnsureCloudProviderSecret(ctx, eContextK8s, secret, nil)).To(HaveOccurred())
+			})
+		})
 	})
 })
+
+func userKubeconfig(server, user string) []byte {
+	return []byte(fmt.Sprintf(`apiVersion: v1
+kind: Config
+current-context: ironcore
+clusters:
+- name: ironcore
+  cluster:
+    server: %s
+contexts:
+- name: ironcore
+  context:
+    cluster: ironcore
+    user: user
+users:
+- name: user
+  user:
+    %s
+`, server, user))
+}
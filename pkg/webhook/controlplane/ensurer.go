@@ -9,9 +9,11 @@ import (
 
 	"github.com/Masterminds/semver/v3"
 	"github.com/coreos/go-systemd/v22/unit"
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
 	extensionscontextwebhook "github.com/gardener/gardener/extensions/pkg/webhook/context"
 	"github.com/gardener/gardener/extensions/pkg/webhook/controlplane/genericmutator"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/component/nodemanagement/machinecontrollermanager"
 	"github.com/go-logr/logr"
@@ -19,25 +21,79 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	vpaautoscalingv1 "k8s.io/autoscaler/vertical-pod-autoscaler/pkg/apis/autoscaling.k8s.io/v1"
 	kubeletconfigv1beta1 "k8s.io/kubelet/config/v1beta1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/ironcore-dev/gardener-extension-provider-ironcore/imagevector"
+	apisironcore "github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/apis/ironcore"
+	"github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/apis/ironcore/helper"
 	"github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/ironcore"
 )
 
+const (
+	volumeUdevRulesFilePath = "/etc/udev/rules.d/90-ironcore-volumes.rules"
+	volumeUdevRulesUnitName = "ironcore-volume-udev-rules.service"
+
+	hostnameScriptFilePath = "/opt/bin/ironcore-hostname.sh"
+	hostnameEnvFilePath    = "/var/lib/ironcore/hostname.env"
+	hostnameUnitName       = "ironcore-hostname.service"
+
+	kubeletClientCertificatePath = "/var/lib/kubelet/pki/kubelet-client-current.pem"
+)
+
+// hostnameScript pins the hostname of a new node to the name of its ironcore machine, which the machine-controller-manager
+// provider writes to /etc/hostname through the ignition of the machine, and exposes it to the kubelet as
+// HOSTNAME_OVERRIDE. The override is determined once per node: nodes which already registered with the cluster keep
+// their name, and without a machine name the override stays empty, so that the kubelet falls back to its default.
+const hostnameScript = `#!/bin/bash
+set -o errexit
+set -o nounset
+set -o pipefail
+
+if [[ -f ` + hostnameEnvFilePath + ` ]]; then
+  exit 0
+fi
+
+machine_name=""
+if [[ ! -e ` + kubeletClientCertificatePath + ` && -s /etc/hostname ]]; then
+  machine_name="$(tr -d '[:space:]' < /etc/hostname | tr '[:upper:]' '[:lower:]')"
+fi
+if [[ "${machine_name}" == "localhost" ]]; then
+  machine_name=""
+fi
+
+if [[ -n "${machine_name}" && "$(hostname)" != "${machine_name}" ]]; then
+  hostnamectl set-hostname "${machine_name}"
+fi
+
+mkdir -p "$(dirname ` + hostnameEnvFilePath + `)"
+echo "HOSTNAME_OVERRIDE=${machine_name}" > ` + hostnameEnvFilePath + `
+`
+
+// volumeUdevRules makes sure that ironcore volumes are always discoverable via their virtio serial, which the CSI
+// driver uses to map a volume to its block device.
+const volumeUdevRules = `ACTION=="add|change", SUBSYSTEM=="block", KERNEL=="vd*[!0-9]", ATTRS{serial}=="?*", ENV{ID_SERIAL}="$attr{serial}", SYMLINK+="disk/by-id/virtio-$attr{serial}"
+ACTION=="add|change", SUBSYSTEM=="block", KERNEL=="vd*[0-9]", ATTRS{serial}=="?*", ENV{ID_SERIAL}="$attr{serial}", SYMLINK+="disk/by-id/virtio-$attr{serial}-part%n"
+`
+
 // NewEnsurer creates a new controlplane ensurer.
-func NewEnsurer(logger logr.Logger, gardenletManagesMCM bool) genericmutator.Ensurer {
+func NewEnsurer(client client.Client, logger logr.Logger, gardenletManagesMCM bool) genericmutator.Ensurer {
 	return &ensurer{
+		client: client,
 		logger: logger.WithName("ironcore-controlplane-ensurer"),
 	}
 }
 
 type ensurer struct {
 	genericmutator.NoopEnsurer
+	client client.Client
 	logger logr.Logger
 }
 
-// ImageVector is exposed for testing.
-var ImageVector = imagevector.ImageVector()
+var (
+	// ImageVector is exposed for testing.
+	ImageVector = imagevector.ImageVector()
+)
 
 // EnsureMachineControllerManagerDeployment ensures that the machine-controller-manager deployment conforms to the provider requirements.
 func (e *ensurer) EnsureMachineControllerManagerDeployment(ctx context.Context, gctx extensionscontextwebhook.GardenContext, newObj, _ *appsv1.Deployment) error {
@@ -149,10 +205,19 @@ func (e *ensurer) EnsureKubeletServiceUnitOptions(_ context.Context, _ extension
 		opt.Value = extensionswebhook.SerializeCommandLine(command, 1, " \\\n    ")
 	}
 
+	// The hostname override is provided by the ironcore-hostname unit. The kubelet starts even if the unit fails, the
+	// environment file is optional then and the kubelet keeps its default hostname.
+	for _, name := range []string{"After", "Wants"} {
+		new = extensionswebhook.EnsureUnitOption(new, &unit.UnitOption{
+			Section: "Unit",
+			Name:    name,
+			Value:   hostnameUnitName,
+		})
+	}
 	new = extensionswebhook.EnsureUnitOption(new, &unit.UnitOption{
 		Section: "Service",
-		Name:    "ExecStartPre",
-		Value:   `/bin/sh -c 'hostnamectl set-hostname $(hostname -f)'`,
+		Name:    "EnvironmentFile",
+		Value:   "-" + hostnameEnvFilePath,
 	})
 
 	return new, nil
@@ -160,25 +225,168 @@ func (e *ensurer) EnsureKubeletServiceUnitOptions(_ context.Context, _ extension
 
 func ensureKubeletCommandLineArgs(command []string) []string {
 	command = extensionswebhook.EnsureStringWithPrefix(command, "--cloud-provider=", "external")
+	command = extensionswebhook.EnsureStringWithPrefix(command, "--hostname-override=", "${HOSTNAME_OVERRIDE}")
 	return command
 }
 
 // EnsureKubeletConfiguration ensures that the kubelet configuration conforms to the provider requirements.
-func (e *ensurer) EnsureKubeletConfiguration(_ context.Context, _ extensionscontextwebhook.GardenContext, _ *semver.Version, _, _ *kubeletconfigv1beta1.KubeletConfiguration) error {
+func (e *ensurer) EnsureKubeletConfiguration(ctx context.Context, gctx extensionscontextwebhook.GardenContext, _ *semver.Version, new, _ *kubeletconfigv1beta1.KubeletConfiguration) error {
+	poolName, ok := workerPoolFromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	cluster, err := gctx.GetCluster(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get cluster: %w", err)
+	}
+
+	pool := findWorker(cluster.Shoot.Spec.Provider.Workers, poolName)
+	if pool == nil {
+		return nil
+	}
+
+	// The kubelet configuration derived from the MachineClass is only an optimization, so any failure leaves the
+	// kubelet configuration unmodified instead of blocking the OperatingSystemConfig.
+	workerConfig, err := helper.WorkerConfigFromRaw(pool.ProviderConfig)
+	if err != nil {
+		e.logger.Error(err, "Failed to decode worker config, not tuning kubelet configuration", "pool", poolName)
+		return nil
+	}
+	if !ptr.Deref(workerConfig.KubeletConfigFromMachineClass, false) {
+		return nil
+	}
+
+	kubeletConfig, err := e.getMachineClassKubeletConfig(ctx, cluster, poolName)
+	if err != nil {
+		e.logger.Error(err, "Failed to get kubelet configuration of the MachineClass, not tuning kubelet configuration", "pool", poolName)
+		return nil
+	}
+	if kubeletConfig == nil {
+		return nil
+	}
+
+	kubelet := mergedKubeletConfig(cluster.Shoot.Spec.Kubernetes.Kubelet, pool)
+
+	if (kubelet == nil || kubelet.KubeReserved == nil) && len(kubeletConfig.KubeReserved) > 0 {
+		if new.KubeReserved == nil {
+			new.KubeReserved = map[string]string{}
+		}
+		for name, quantity := range kubeletConfig.KubeReserved {
+			new.KubeReserved[string(name)] = quantity.String()
+		}
+	}
+
+	if (kubelet == nil || kubelet.MaxPods == nil) && kubeletConfig.MaxPods != nil {
+		maxPods := *kubeletConfig.MaxPods
+		if new.MaxPods > 0 {
+			maxPods = min(maxPods, new.MaxPods)
+		}
+		new.MaxPods = maxPods
+	}
+
+	return nil
+}
+
+// getMachineClassKubeletConfig returns the kubelet configuration which the worker controller derived from the ironcore
+// MachineClass of the given worker pool. It returns nil if the worker controller has not recorded it (yet).
+func (e *ensurer) getMachineClassKubeletConfig(ctx context.Context, cluster *extensionscontroller.Cluster, poolName string) (*apisironcore.WorkerPoolKubeletConfig, error) {
+	worker := &extensionsv1alpha1.Worker{}
+	if err := e.client.Get(ctx, client.ObjectKey{Namespace: cluster.ObjectMeta.Name, Name: cluster.Shoot.Name}, worker); err != nil {
+		return nil, client.IgnoreNotFound(err)
+	}
+
+	workerStatus, err := helper.WorkerStatusFromRaw(worker.Status.ProviderStatus)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode worker status: %w", err)
+	}
+
+	for i := range workerStatus.KubeletConfigs {
+		if workerStatus.KubeletConfigs[i].PoolName == poolName {
+			return &workerStatus.KubeletConfigs[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func findWorker(workers []gardencorev1beta1.Worker, name string) *gardencorev1beta1.Worker {
+	for i := range workers {
+		if workers[i].Name == name {
+			return &workers[i]
+		}
+	}
 	return nil
 }
 
+// mergedKubeletConfig returns the kubelet configuration of the worker pool if set, otherwise the one of the shoot.
+func mergedKubeletConfig(shootKubelet *gardencorev1beta1.KubeletConfig, pool *gardencorev1beta1.Worker) *gardencorev1beta1.KubeletConfig {
+	if pool.Kubernetes != nil && pool.Kubernetes.Kubelet != nil {
+		return pool.Kubernetes.Kubelet
+	}
+	return shootKubelet
+}
+
 // EnsureKubernetesGeneralConfiguration ensures that the kubernetes general configuration conforms to the provider requirements.
 func (e *ensurer) EnsureKubernetesGeneralConfiguration(_ context.Context, _ extensionscontextwebhook.GardenContext, _, _ *string) error {
 	return nil
 }
 
 // EnsureAdditionalUnits ensures that additional required system units are added.
-func (e *ensurer) EnsureAdditionalUnits(_ context.Context, _ extensionscontextwebhook.GardenContext, _, _ *[]extensionsv1alpha1.Unit) error {
+func (e *ensurer) EnsureAdditionalUnits(_ context.Context, _ extensionscontextwebhook.GardenContext, new, _ *[]extensionsv1alpha1.Unit) error {
+	*new = extensionswebhook.EnsureUnitWithName(*new, extensionsv1alpha1.Unit{
+		Name:    volumeUdevRulesUnitName,
+		Command: ptr.To(extensionsv1alpha1.CommandRestart),
+		Enable:  ptr.To(true),
+		Content: ptr.To(`[Unit]
+Description=Apply udev rules for ironcore volumes
+Before=kubelet.service
+[Service]
+Type=oneshot
+RemainAfterExit=yes
+ExecStart=/bin/sh -c 'udevadm control --reload-rules && udevadm trigger --subsystem-match=block --action=change'
+[Install]
+WantedBy=multi-user.target
+`),
+		FilePaths: []string{volumeUdevRulesFilePath},
+	})
+	*new = extensionswebhook.EnsureUnitWithName(*new, extensionsv1alpha1.Unit{
+		Name:    hostnameUnitName,
+		Command: ptr.To(extensionsv1alpha1.CommandRestart),
+		Enable:  ptr.To(true),
+		Content: ptr.To(`[Unit]
+Description=Set the hostname to the name of the ironcore machine
+Before=kubelet.service
+[Service]
+Type=oneshot
+RemainAfterExit=yes
+ExecStart=` + hostnameScriptFilePath + `
+[Install]
+WantedBy=multi-user.target
+`),
+		FilePaths: []string{hostnameScriptFilePath},
+	})
 	return nil
 }
 
 // EnsureAdditionalFiles ensures that additional required system files are added.
-func (e *ensurer) EnsureAdditionalFiles(_ context.Context, _ extensionscontextwebhook.GardenContext, _, _ *[]extensionsv1alpha1.File) error {
+func (e *ensurer) EnsureAdditionalFiles(_ context.Context, _ extensionscontextwebhook.GardenContext, new, _ *[]extensionsv1alpha1.File) error {
+	*new = extensionswebhook.EnsureFileWithPath(*new, extensionsv1alpha1.File{
+		Path:        volumeUdevRulesFilePath,
+		Permissions: ptr.To[uint32](0644),
+		Content: extensionsv1alpha1.FileContent{
+			Inline: &extensionsv1alpha1.FileContentInline{
+				Data: volumeUdevRules,
+			},
+		},
+	})
+	*new = extensionswebhook.EnsureFileWithPath(*new, extensionsv1alpha1.File{
+		Path:        hostnameScriptFilePath,
+		Permissions: ptr.To[uint32](0755),
+		Content: extensionsv1alpha1.FileContent{
+			Inline: &extensionsv1alpha1.FileContentInline{
+				Data: hostnameScript,
+			},
+		},
+	})
 	return nil
 }
@@ -15,17 +15,24 @@ import (
 	"github.com/gardener/gardener/extensions/pkg/webhook/controlplane/genericmutator"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/component/nodemanagement/machinecontrollermanager"
 	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
 	testutils "github.com/gardener/gardener/pkg/utils/test"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 	"go.uber.org/mock/gomock"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	vpaautoscalingv1 "k8s.io/autoscaler/vertical-pod-autoscaler/pkg/apis/autoscaling.k8s.io/v1"
+	kubeletconfigv1beta1 "k8s.io/kubelet/config/v1beta1"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"github.com/ironcore-dev/gardener-extension-provider-ironcore/pkg/ironcore"
 )
@@ -68,7 +75,7 @@ var _ = Describe("Ensurer", func() {
 
 	BeforeEach(func() {
 		ctrl = gomock.NewController(GinkgoT())
-		ensurer = NewEnsurer(logger, false)
+		ensurer = NewEnsurer(nil, logger, false)
 	})
 
 	AfterEach(func() {
@@ -189,10 +196,7 @@ var _ = Describe("Ensurer", func() {
 	})
 
 	Describe("#EnsureKubeletServiceUnitOptions", func() {
-		var (
-			oldUnitOptions        []*unit.UnitOption
-			hostnamectlUnitOption *unit.UnitOption
-		)
+		var oldUnitOptions []*unit.UnitOption
 
 		BeforeEach(func() {
 			oldUnitOptions = []*unit.UnitOption{
@@ -203,12 +207,6 @@ var _ = Describe("Ensurer", func() {
 	    --config=/var/lib/kubelet/config/kubelet`,
 				},
 			}
-
-			hostnamectlUnitOption = &unit.UnitOption{
-				Section: "Service",
-				Name:    "ExecStartPre",
-				Value:   `/bin/sh -c 'hostnamectl set-hostname $(hostname -f)'`,
-			}
 		})
 
 		It("should modify existing elements of kubelet.service unit options",
@@ -217,9 +215,23 @@ var _ = Describe("Ensurer", func() {
 					{
 						Section: "Service",
 						Name:    "ExecStart",
-						Value:   "/opt/bin/hyperkube kubelet \\\n    --config=/var/lib/kubelet/config/kubelet \\\n    --cloud-provider=external",
+						Value:   "/opt/bin/hyperkube kubelet \\\n    --config=/var/lib/kubelet/config/kubelet \\\n    --cloud-provider=external \\\n    --hostname-override=${HOSTNAME_OVERRIDE}",
+					},
+					{
+						Section: "Unit",
+						Name:    "After",
+						Value:   "ironcore-hostname.service",
+					},
+					{
+						Section: "Unit",
+						Name:    "Wants",
+						Value:   "ironcore-hostname.service",
+					},
+					{
+						Section: "Service",
+						Name:    "EnvironmentFile",
+						Value:   "-/var/lib/ironcore/hostname.env",
 					},
-					hostnamectlUnitOption,
 				}
 
 				opts, err := ensurer.EnsureKubeletServiceUnitOptions(ctx, dummyContext, semver.MustParse("1.23.0"), oldUnitOptions, nil)
@@ -227,6 +239,181 @@ var _ = Describe("Ensurer", func() {
 				Expect(opts).To(Equal(newUnitOptions))
 			},
 		)
+
+		It("should not use hostnamectl on kubelet start", func() {
+			opts, err := ensurer.EnsureKubeletServiceUnitOptions(ctx, dummyContext, semver.MustParse("1.23.0"), oldUnitOptions, nil)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(extensionswebhook.UnitOptionWithSectionAndName(opts, "Service", "ExecStartPre")).To(BeNil())
+		})
+	})
+
+	Describe("#EnsureKubeletConfiguration", func() {
+		var (
+			poolCtx       context.Context
+			poolShoot     *gardencorev1beta1.Shoot
+			worker        *extensionsv1alpha1.Worker
+			gctx          gcontext.GardenContext
+			kubeletConfig *kubeletconfigv1beta1.KubeletConfiguration
+		)
+
+		BeforeEach(func() {
+			poolCtx = context.WithValue(ctx, workerPoolContextKey{}, "pool")
+
+			poolShoot = &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: "shoot"},
+				Spec: gardencorev1beta1.ShootSpec{
+					Kubernetes: gardencorev1beta1.Kubernetes{Version: "1.30.0"},
+					Provider: gardencorev1beta1.Provider{
+						Workers: []gardencorev1beta1.Worker{{
+							Name:    "pool",
+							Machine: gardencorev1beta1.Machine{Type: "x3-large"},
+							ProviderConfig: &runtime.RawExtension{
+								Raw: []byte(`{"apiVersion":"ironcore.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","kubeletConfigFromMachineClass":true}`),
+							},
+						}},
+					},
+				},
+			}
+			gctx = gcontext.NewInternalGardenContext(&extensionscontroller.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: namespace},
+				Shoot:      poolShoot,
+			})
+
+			worker = &extensionsv1alpha1.Worker{
+				ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "shoot"},
+				Status: extensionsv1alpha1.WorkerStatus{
+					DefaultStatus: extensionsv1alpha1.DefaultStatus{
+						ProviderStatus: &runtime.RawExtension{
+							Raw: []byte(`{"apiVersion":"ironcore.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerStatus",` +
+								`"kubeletConfigs":[{"poolName":"pool","kubeReserved":{"cpu":"80m","memory":"2662Mi"},"maxPods":64}]}`),
+						},
+					},
+				},
+			}
+
+			kubeletConfig = &kubeletconfigv1beta1.KubeletConfiguration{
+				KubeReserved: map[string]string{"cpu": "80m", "memory": "1Gi"},
+				MaxPods:      110,
+			}
+		})
+
+		newEnsurer := func(objects ...client.Object) genericmutator.Ensurer {
+			scheme := runtime.NewScheme()
+			Expect(extensionsv1alpha1.AddToScheme(scheme)).To(Succeed())
+			return NewEnsurer(fakeclient.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build(), logger, false)
+		}
+
+		It("should apply the kubelet configuration derived from the MachineClass by the worker controller", func() {
+			Expect(newEnsurer(worker).EnsureKubeletConfiguration(poolCtx, gctx, nil, kubeletConfig, nil)).To(Succeed())
+
+			Expect(kubeletConfig.KubeReserved).To(Equal(map[string]string{"cpu": "80m", "memory": "2662Mi"}))
+			Expect(kubeletConfig.MaxPods).To(Equal(int32(64)))
+		})
+
+		It("should not override the kubelet configuration of the worker pool", func() {
+			poolShoot.Spec.Provider.Workers[0].Kubernetes = &gardencorev1beta1.WorkerKubernetes{
+				Kubelet: &gardencorev1beta1.KubeletConfig{
+					KubeReserved: &gardencorev1beta1.KubeletConfigReserved{CPU: ptr.To(resource.MustParse("80m"))},
+					MaxPods:      ptr.To[int32](110),
+				},
+			}
+
+			Expect(newEnsurer(worker).EnsureKubeletConfiguration(poolCtx, gctx, nil, kubeletConfig, nil)).To(Succeed())
+
+			Expect(kubeletConfig.KubeReserved).To(Equal(map[string]string{"cpu": "80m", "memory": "1Gi"}))
+			Expect(kubeletConfig.MaxPods).To(Equal(int32(110)))
+		})
+
+		It("should not change the kubelet configuration if the worker pool does not opt in", func() {
+			poolShoot.Spec.Provider.Workers[0].ProviderConfig = nil
+
+			Expect(newEnsurer(worker).EnsureKubeletConfiguration(poolCtx, gctx, nil, kubeletConfig, nil)).To(Succeed())
+
+			Expect(kubeletConfig.KubeReserved).To(Equal(map[string]string{"cpu": "80m", "memory": "1Gi"}))
+			Expect(kubeletConfig.MaxPods).To(Equal(int32(110)))
+		})
+
+		It("should not change the kubelet configuration if the worker controller has not recorded it", func() {
+			worker.Status.ProviderStatus = nil
+
+			Expect(newEnsurer(worker).EnsureKubeletConfiguration(poolCtx, gctx, nil, kubeletConfig, nil)).To(Succeed())
+
+			Expect(kubeletConfig.KubeReserved).To(Equal(map[string]string{"cpu": "80m", "memory": "1Gi"}))
+			Expect(kubeletConfig.MaxPods).To(Equal(int32(110)))
+		})
+
+		It("should not change the kubelet configuration if the worker cannot be read", func() {
+			ensurer := NewEnsurer(fakeclient.NewClientBuilder().WithScheme(runtime.NewScheme()).Build(), logger, false)
+
+			Expect(ensurer.EnsureKubeletConfiguration(poolCtx, gctx, nil, kubeletConfig, nil)).To(Succeed())
+
+			Expect(kubeletConfig.KubeReserved).To(Equal(map[string]string{"cpu": "80m", "memory": "1Gi"}))
+			Expect(kubeletConfig.MaxPods).To(Equal(int32(110)))
+		})
+
+		It("should not change the kubelet configuration without a worker pool", func() {
+			Expect(newEnsurer(worker).EnsureKubeletConfiguration(ctx, gctx, nil, kubeletConfig, nil)).To(Succeed())
+
+			Expect(kubeletConfig.KubeReserved).To(Equal(map[string]string{"cpu": "80m", "memory": "1Gi"}))
+			Expect(kubeletConfig.MaxPods).To(Equal(int32(110)))
+		})
+	})
+
+	Describe("#EnsureAdditionalFiles", func() {
+		It("should add the udev rules for ironcore volumes and the hostname script", func() {
+			files := []extensionsv1alpha1.File{{Path: "/etc/other"}}
+
+			Expect(ensurer.EnsureAdditionalFiles(ctx, dummyContext, &files, nil)).To(Succeed())
+			Expect(files).To(ConsistOf(
+				extensionsv1alpha1.File{Path: "/etc/other"},
+				extensionsv1alpha1.File{
+					Path:        "/etc/udev/rules.d/90-ironcore-volumes.rules",
+					Permissions: ptr.To[uint32](0644),
+					Content: extensionsv1alpha1.FileContent{
+						Inline: &extensionsv1alpha1.FileContentInline{Data: volumeUdevRules},
+					},
+				},
+				extensionsv1alpha1.File{
+					Path:        "/opt/bin/ironcore-hostname.sh",
+					Permissions: ptr.To[uint32](0755),
+					Content: extensionsv1alpha1.FileContent{
+						Inline: &extensionsv1alpha1.FileContentInline{Data: hostnameScript},
+					},
+				},
+			))
+		})
+	})
+
+	Describe("#EnsureAdditionalUnits", func() {
+		It("should add the units applying the udev rules and setting the hostname", func() {
+			var units []extensionsv1alpha1.Unit
+
+			Expect(ensurer.EnsureAdditionalUnits(ctx, dummyContext, &units, nil)).To(Succeed())
+			Expect(units).To(ConsistOf(
+				MatchFields(IgnoreExtras, Fields{
+					"Name":      Equal("ironcore-volume-udev-rules.service"),
+					"Command":   PointTo(Equal(extensionsv1alpha1.CommandRestart)),
+					"Enable":    PointTo(BeTrue()),
+					"FilePaths": ConsistOf("/etc/udev/rules.d/90-ironcore-volumes.rules"),
+				}),
+				MatchFields(IgnoreExtras, Fields{
+					"Name":    Equal("ironcore-hostname.service"),
+					"Command": PointTo(Equal(extensionsv1alpha1.CommandRestart)),
+					"Enable":  PointTo(BeTrue()),
+					"Content": PointTo(Equal(`[Unit]
+Description=Set the hostname to the name of the ironcore machine
+Before=kubelet.service
+[Service]
+Type=oneshot
+RemainAfterExit=yes
+ExecStart=/opt/bin/ironcore-hostname.sh
+[Install]
+WantedBy=multi-user.target
+`)),
+					"FilePaths": ConsistOf("/opt/bin/ironcore-hostname.sh"),
+				}),
+			))
+		})
 	})
 
 	Describe("#EnsureMachineControllerManagerDeployment", func() {
@@ -241,7 +428,7 @@ var _ = Describe("Ensurer", func() {
 
 		Context("when gardenlet manages MCM", func() {
 			BeforeEach(func() {
-				ensurer = NewEnsurer(logger, true)
+				ensurer = NewEnsurer(nil, logger, true)
 				DeferCleanup(testutils.WithVar(&ImageVector, imagevectorutils.ImageVector{{
 					Name:       "machine-controller-manager-provider-ironcore",
 					Repository: ptr.To("foo"),
@@ -284,7 +471,7 @@ var _ = Describe("Ensurer", func() {
 
 		Context("when gardenlet manages MCM", func() {
 			BeforeEach(func() {
-				ensurer = NewEnsurer(logger, true)
+				ensurer = NewEnsurer(nil, logger, true)
 			})
 
 			It("should inject the sidecar container policy", func() {
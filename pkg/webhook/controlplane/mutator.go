@@ -0,0 +1,36 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+
+	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type workerPoolContextKey struct{}
+
+// workerPoolMutator passes the worker pool of an OperatingSystemConfig to the ensurer, since the generic mutator
+// only hands over the contents of the OperatingSystemConfig.
+type workerPoolMutator struct {
+	extensionswebhook.Mutator
+}
+
+// Mutate implements extensionswebhook.Mutator.
+func (m *workerPoolMutator) Mutate(ctx context.Context, new, old client.Object) error {
+	if osc, ok := new.(*extensionsv1alpha1.OperatingSystemConfig); ok {
+		if poolName, ok := osc.Labels[v1beta1constants.LabelWorkerPool]; ok {
+			ctx = context.WithValue(ctx, workerPoolContextKey{}, poolName)
+		}
+	}
+	return m.Mutator.Mutate(ctx, new, old)
+}
+
+func workerPoolFromContext(ctx context.Context) (string, bool) {
+	poolName, ok := ctx.Value(workerPoolContextKey{}).(string)
+	return poolName, ok
+}